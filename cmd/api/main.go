@@ -2,23 +2,34 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/eddie023/wex-tag/ent"
 	"github.com/eddie023/wex-tag/internal/build"
 	"github.com/eddie023/wex-tag/pkg/api"
 	"github.com/eddie023/wex-tag/pkg/api/service"
 	"github.com/eddie023/wex-tag/pkg/config"
 	"github.com/eddie023/wex-tag/pkg/db"
+	"github.com/eddie023/wex-tag/pkg/debug"
+	"github.com/eddie023/wex-tag/pkg/health"
 	"github.com/eddie023/wex-tag/pkg/logger"
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/eddie023/wex-tag/pkg/tracing"
 	"github.com/eddie023/wex-tag/pkg/types"
 
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
 	_ "github.com/lib/pq"
 )
 
@@ -44,6 +55,22 @@ func run(slog *slog.Logger) error {
 
 	slog.Debug("using config", "config", cfg)
 
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg)
+	if err != nil {
+		slog.Error("tracing", "err", err)
+		return err
+	}
+	// flush the spans which are not exported yet, once the server is shut down or when the startup fails
+	flushTracing := sync.OnceFunc(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("tracing shutdown", "err", err)
+		}
+	})
+	defer flushTracing()
+
 	// try to connect to postgres server
 	db, err := db.NewConnection(cfg)
 	if err != nil {
@@ -51,6 +78,28 @@ func run(slog *slog.Logger) error {
 		return err
 	}
 
+	metrics.Registry.MustRegister(collectors.NewDBStatsCollector(db.SQL, "postgres"))
+
+	// the debug server exposes profiling and metrics, thus DebugHost must not be reachable publicly
+	debugServer := &http.Server{
+		Addr:    cfg.API.DebugHost,
+		Handler: debug.Mux(),
+	}
+
+	go func() {
+		slog.Info("debug server listening on", "host", cfg.API.DebugHost)
+
+		if err := debugServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("debug server", "err", err)
+		}
+	}()
+
+	exchangeRateService, treasury, err := newExchangeRateService(cfg, db.Client)
+	if err != nil {
+		slog.Error("exchange rate providers", "err", err)
+		return err
+	}
+
 	swagger, err := types.GetSwagger()
 	if err != nil {
 		slog.Error("swagger spec", "err", err)
@@ -59,15 +108,27 @@ func run(slog *slog.Logger) error {
 
 	swagger.Servers = nil
 
+	checker := newHealthChecker(cfg, db, treasury)
+
+	idempotencyStore := &service.IdempotencyStore{
+		Ent:   db.Client,
+		TTL:   cfg.API.IdempotencyKeyTTL,
+		Lease: cfg.API.IdempotencyKeyLease,
+	}
+
 	api := api.API{
 		Config:  cfg,
 		Db:      *db.Client,
 		Swagger: swagger,
 		Logger:  slog,
 		TransactionService: &service.Service{
-			Ent: db.Client,
+			Ent:           db.Client,
+			ExchangeRates: exchangeRateService,
+			RoundingMode:  exchangeRateService.RoundingMode,
 		},
-		ExchangeRateService: &service.ExchangeRateGetter{},
+		ExchangeRateService: exchangeRateService,
+		IdempotencyService:  idempotencyStore,
+		Health:              checker,
 	}
 
 	server := &http.Server{
@@ -78,12 +139,20 @@ func run(slog *slog.Logger) error {
 	// server run context
 	serverCtx, serverStopCtx := context.WithCancel(context.Background())
 
+	// expired idempotency keys are deleted in the background rather than by the requests reserving a key
+	go idempotencyStore.RunExpiryCleanup(serverCtx, cfg.API.IdempotencyKeyCleanupInterval)
+
 	// Listen for syscall signals for process to interrput/quit
 	sig := make(chan os.Signal, 1)
-	signal.Notify(sig, syscall.SIGINT)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
 		<-sig
 
+		// report not ready and give load balancers time to stop routing new requests before shutting down
+		checker.Shutdown()
+		slog.Warn("draining server before shutdown", "period", cfg.API.ShutdownDrainPeriod)
+		time.Sleep(cfg.API.ShutdownDrainPeriod)
+
 		// Shutdown signal with grace period of 10 seconds
 		shutdownCtx, cancel := context.WithTimeout(serverCtx, 10*time.Second)
 		defer cancel()
@@ -97,25 +166,144 @@ func run(slog *slog.Logger) error {
 
 		// Trigger graceful shutdown
 		slog.Warn("gracefully shutting down server", "deadline exceeded", false)
-		err := server.Shutdown(shutdownCtx)
-		if err != nil {
-			log.Fatal(err)
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("server shutdown", "err", err)
 		}
 
+		if err := debugServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("debug server shutdown", "err", err)
+		}
+
+		flushTracing()
+
 		serverStopCtx()
 	}()
 
+	serverErrors := make(chan error, 1)
+
 	go func() {
 		slog.Info("server listening on", "host", cfg.API.Host)
 
-		err = server.ListenAndServe()
-		if err != nil {
+		// ErrServerClosed is returned as soon as the shutdown starts, the in-flight requests are still being drained
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			// Add sentry exception here
-			log.Fatal(err)
+			serverErrors <- err
 		}
 	}()
 
-	<-serverCtx.Done()
+	select {
+	case err := <-serverErrors:
+		return err
+	case <-serverCtx.Done():
+	}
 
 	return nil
 }
+
+// newHealthChecker will check the database connection and migration version, along with the treasury API when a probe
+// currency is configured. The treasury API is called directly rather than through the exchange rate cache and store, which
+// would answer the probe without calling the API once the probe rate is known.
+func newHealthChecker(cfg *config.ApiConfig, conn *db.DB, treasury *service.ExchangeRateGetter) *health.Checker {
+	checker := &health.Checker{
+		Timeout: cfg.API.ReadinessCheckTimeout,
+		Checks: []health.Check{
+			{Name: "database", Check: conn.Ping},
+			{Name: "migrations", Check: conn.CheckMigrationVersion},
+		},
+	}
+
+	if treasury != nil && cfg.ExchangeRate.ProbeCountry != "" && cfg.ExchangeRate.ProbeCurrency != "" {
+		// the probe has no circuit breaker, thus its failures do not open the circuit of the requests
+		probe := *treasury
+		probe.Breaker = nil
+
+		// the API is only called once every probe interval rather than on every readiness probe
+		lookup := health.Cached(func(ctx context.Context) error {
+			// the probe is bounded by the check timeout, thus a failing API is not retried beyond it
+			_, err := probe.GetExchangeRate(ctx, service.ExchangeRatePayload{
+				CountryName: cfg.ExchangeRate.ProbeCountry,
+				Currency:    cfg.ExchangeRate.ProbeCurrency,
+				RecordDate:  time.Now(),
+			})
+
+			return err
+		}, cfg.ExchangeRate.ProbeInterval)
+
+		checker.Checks = append(checker.Checks, health.Check{
+			Name: "exchangeRate",
+			Check: func(ctx context.Context) error {
+				// the requests calling the API already fail fast while the circuit is open
+				if treasury.Breaker != nil && treasury.Breaker.State() == service.CircuitOpen {
+					return errors.New("treasury API circuit breaker is open")
+				}
+
+				return lookup(ctx)
+			},
+		})
+	}
+
+	return checker
+}
+
+// newExchangeRateService will build the chain of exchange rate providers in the configured order, along with the treasury
+// API client when the treasury provider is configured.
+func newExchangeRateService(cfg *config.ApiConfig, client *ent.Client) (*service.ExchangeRateProviderChain, *service.ExchangeRateGetter, error) {
+	mode, err := service.ParseRateSelectionMode(cfg.ExchangeRate.RatePolicy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cfg.ExchangeRate.RateLookbackMonths < 1 {
+		return nil, nil, fmt.Errorf("exchange rate lookback must be at least 1 month, got %d", cfg.ExchangeRate.RateLookbackMonths)
+	}
+
+	rounding, err := money.ParseRoundingMode(cfg.Money.RoundingMode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chain := &service.ExchangeRateProviderChain{
+		MaxConcurrentLookups: cfg.ExchangeRate.MaxConcurrentLookups,
+		DefaultPolicy: service.RateSelectionPolicy{
+			Mode:           mode,
+			LookbackMonths: cfg.ExchangeRate.RateLookbackMonths,
+		},
+		RoundingMode: rounding,
+	}
+
+	var treasury *service.ExchangeRateGetter
+
+	for _, name := range cfg.ExchangeRate.Providers {
+		switch strings.TrimSpace(name) {
+		case service.TreasuryProvider:
+			treasury, err = service.NewExchangeRateGetter(cfg)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			store := &service.ExchangeRateStore{
+				ExchangeRateGetter: treasury,
+				Ent:                client,
+			}
+
+			chain.Providers = append(chain.Providers, service.NewExchangeRateCache(store, cfg.ExchangeRate.CacheSize, cfg.ExchangeRate.CacheTTL))
+		case service.ECBProvider:
+			chain.Providers = append(chain.Providers, &service.ECBExchangeRateProvider{
+				Source:          cfg.ExchangeRate.ECBSource,
+				RefreshInterval: cfg.ExchangeRate.ECBRefreshInterval,
+				LoadTimeout:     cfg.ExchangeRate.ECBLoadTimeout,
+			})
+		case service.FileProvider:
+			provider, err := service.NewFileExchangeRateProvider(cfg.ExchangeRate.RatesFile)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			chain.Providers = append(chain.Providers, provider)
+		default:
+			return nil, nil, fmt.Errorf("unknown exchange rate provider '%s'", name)
+		}
+	}
+
+	return chain, treasury, nil
+}
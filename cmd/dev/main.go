@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql/schema"
@@ -15,7 +18,9 @@ import (
 
 	gomigrate "github.com/golang-migrate/migrate/v4"
 
+	"github.com/eddie023/wex-tag/pkg/api/service"
 	"github.com/eddie023/wex-tag/pkg/config"
+	"github.com/eddie023/wex-tag/pkg/db"
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
 
@@ -138,11 +143,101 @@ var Migration = cli.Command{
 	Subcommands: []*cli.Command{&CreateCommand, &InitCommand, &UpCommand},
 }
 
+// sync treasury rates of exchange dataset into local exchange rate table
+var SyncCommand = cli.Command{
+	Name: "sync",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "since", Usage: "only sync rates with record date on or after given date in YYYY-MM-DD format"},
+		&cli.StringFlag{Name: "file", Usage: "import rates from previously downloaded dataset file instead of calling treasury API"},
+		&cli.StringFlag{Name: "format", Usage: "format of the dataset file, either 'json' or 'csv'. Defaults to file extension"},
+		&cli.IntFlag{Name: "page-size", Value: service.DefaultSyncPageSize},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+
+		err := godotenv.Load()
+		if err != nil {
+			return err
+		}
+
+		cfg, err := config.GetParsedConfig()
+		if err != nil {
+			return err
+		}
+
+		err = conf.Parse(os.Args, "", cfg)
+		if err != nil {
+			return err
+		}
+
+		var since *time.Time
+		if c.String("since") != "" {
+			sinceDate, err := time.Parse(time.DateOnly, c.String("since"))
+			if err != nil {
+				return errors.WithMessage(err, "invalid since date")
+			}
+
+			since = &sinceDate
+		}
+
+		conn, err := db.NewConnection(cfg)
+		if err != nil {
+			return errors.WithMessage(err, "db.NewConnection")
+		}
+		defer conn.Client.Close()
+
+		treasury, err := service.NewExchangeRateGetter(cfg)
+		if err != nil {
+			return errors.WithMessage(err, "service.NewExchangeRateGetter")
+		}
+
+		store := &service.ExchangeRateStore{
+			ExchangeRateGetter: treasury,
+			Ent:                conn.Client,
+		}
+
+		var count int
+
+		if file := c.String("file"); file != "" {
+			format := c.String("format")
+			if format == "" {
+				format = strings.TrimPrefix(filepath.Ext(file), ".")
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			count, err = store.ImportExchangeRates(ctx, f, strings.ToLower(format), since)
+			if err != nil {
+				return errors.WithMessage(err, "ImportExchangeRates")
+			}
+		} else {
+			count, err = store.SyncExchangeRates(ctx, since, c.Int("page-size"))
+			if err != nil {
+				return errors.WithMessagef(err, "SyncExchangeRates stored %d rates before failing", count)
+			}
+		}
+
+		fmt.Printf("successfully synced %d exchange rates\n", count)
+
+		return nil
+	},
+}
+
+var Rates = cli.Command{
+	Name:        "rates",
+	Subcommands: []*cli.Command{&SyncCommand},
+}
+
 func main() {
 	app := &cli.App{
 		Name: "dev",
 		Commands: []*cli.Command{
 			&Migration,
+			&Rates,
 		},
 	}
 
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/eddie023/wex-tag/ent/idempotencykey"
 	"github.com/eddie023/wex-tag/ent/transaction"
 )
 
@@ -73,7 +75,9 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			transaction.Table: transaction.ValidColumn,
+			exchangerate.Table:   exchangerate.ValidColumn,
+			idempotencykey.Table: idempotencykey.ValidColumn,
+			transaction.Table:    transaction.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/eddie023/wex-tag/ent/idempotencykey"
 	"github.com/eddie023/wex-tag/ent/transaction"
 
 	"entgo.io/ent/dialect/sql"
@@ -13,8 +15,45 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 1)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 3)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   exchangerate.Table,
+			Columns: exchangerate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: exchangerate.FieldID,
+			},
+		},
+		Type: "ExchangeRate",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			exchangerate.FieldCountry:             {Type: field.TypeString, Column: exchangerate.FieldCountry},
+			exchangerate.FieldCurrency:            {Type: field.TypeString, Column: exchangerate.FieldCurrency},
+			exchangerate.FieldCountryCurrencyDesc: {Type: field.TypeString, Column: exchangerate.FieldCountryCurrencyDesc},
+			exchangerate.FieldRate:                {Type: field.TypeFloat64, Column: exchangerate.FieldRate},
+			exchangerate.FieldRecordDate:          {Type: field.TypeTime, Column: exchangerate.FieldRecordDate},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   idempotencykey.Table,
+			Columns: idempotencykey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		},
+		Type: "IdempotencyKey",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			idempotencykey.FieldKey:            {Type: field.TypeString, Column: idempotencykey.FieldKey},
+			idempotencykey.FieldRequestHash:    {Type: field.TypeString, Column: idempotencykey.FieldRequestHash},
+			idempotencykey.FieldResponseStatus: {Type: field.TypeInt, Column: idempotencykey.FieldResponseStatus},
+			idempotencykey.FieldResponseBody:   {Type: field.TypeBytes, Column: idempotencykey.FieldResponseBody},
+			idempotencykey.FieldCreatedAt:      {Type: field.TypeTime, Column: idempotencykey.FieldCreatedAt},
+			idempotencykey.FieldExpiresAt:      {Type: field.TypeTime, Column: idempotencykey.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   transaction.Table,
 			Columns: transaction.Columns,
@@ -25,9 +64,13 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Transaction",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			transaction.FieldDate:        {Type: field.TypeTime, Column: transaction.FieldDate},
-			transaction.FieldAmountInUsd: {Type: field.TypeFloat64, Column: transaction.FieldAmountInUsd},
-			transaction.FieldDescription: {Type: field.TypeString, Column: transaction.FieldDescription},
+			transaction.FieldDate:             {Type: field.TypeTime, Column: transaction.FieldDate},
+			transaction.FieldAmountInUsd:      {Type: field.TypeFloat64, Column: transaction.FieldAmountInUsd},
+			transaction.FieldDescription:      {Type: field.TypeString, Column: transaction.FieldDescription},
+			transaction.FieldOriginalAmount:   {Type: field.TypeFloat64, Column: transaction.FieldOriginalAmount},
+			transaction.FieldOriginalCurrency: {Type: field.TypeString, Column: transaction.FieldOriginalCurrency},
+			transaction.FieldExchangeRate:     {Type: field.TypeFloat64, Column: transaction.FieldExchangeRate},
+			transaction.FieldExchangeRateDate: {Type: field.TypeTime, Column: transaction.FieldExchangeRateDate},
 		},
 	}
 	return graph
@@ -39,6 +82,141 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (erq *ExchangeRateQuery) addPredicate(pred func(s *sql.Selector)) {
+	erq.predicates = append(erq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ExchangeRateQuery builder.
+func (erq *ExchangeRateQuery) Filter() *ExchangeRateFilter {
+	return &ExchangeRateFilter{config: erq.config, predicateAdder: erq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ExchangeRateMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ExchangeRateMutation builder.
+func (m *ExchangeRateMutation) Filter() *ExchangeRateFilter {
+	return &ExchangeRateFilter{config: m.config, predicateAdder: m}
+}
+
+// ExchangeRateFilter provides a generic filtering capability at runtime for ExchangeRateQuery.
+type ExchangeRateFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ExchangeRateFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ExchangeRateFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(exchangerate.FieldID))
+}
+
+// WhereCountry applies the entql string predicate on the country field.
+func (f *ExchangeRateFilter) WhereCountry(p entql.StringP) {
+	f.Where(p.Field(exchangerate.FieldCountry))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *ExchangeRateFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(exchangerate.FieldCurrency))
+}
+
+// WhereCountryCurrencyDesc applies the entql string predicate on the country_currency_desc field.
+func (f *ExchangeRateFilter) WhereCountryCurrencyDesc(p entql.StringP) {
+	f.Where(p.Field(exchangerate.FieldCountryCurrencyDesc))
+}
+
+// WhereRate applies the entql float64 predicate on the rate field.
+func (f *ExchangeRateFilter) WhereRate(p entql.Float64P) {
+	f.Where(p.Field(exchangerate.FieldRate))
+}
+
+// WhereRecordDate applies the entql time.Time predicate on the record_date field.
+func (f *ExchangeRateFilter) WhereRecordDate(p entql.TimeP) {
+	f.Where(p.Field(exchangerate.FieldRecordDate))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ikq *IdempotencyKeyQuery) addPredicate(pred func(s *sql.Selector)) {
+	ikq.predicates = append(ikq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the IdempotencyKeyQuery builder.
+func (ikq *IdempotencyKeyQuery) Filter() *IdempotencyKeyFilter {
+	return &IdempotencyKeyFilter{config: ikq.config, predicateAdder: ikq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *IdempotencyKeyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Filter() *IdempotencyKeyFilter {
+	return &IdempotencyKeyFilter{config: m.config, predicateAdder: m}
+}
+
+// IdempotencyKeyFilter provides a generic filtering capability at runtime for IdempotencyKeyQuery.
+type IdempotencyKeyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *IdempotencyKeyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *IdempotencyKeyFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(idempotencykey.FieldID))
+}
+
+// WhereKey applies the entql string predicate on the key field.
+func (f *IdempotencyKeyFilter) WhereKey(p entql.StringP) {
+	f.Where(p.Field(idempotencykey.FieldKey))
+}
+
+// WhereRequestHash applies the entql string predicate on the request_hash field.
+func (f *IdempotencyKeyFilter) WhereRequestHash(p entql.StringP) {
+	f.Where(p.Field(idempotencykey.FieldRequestHash))
+}
+
+// WhereResponseStatus applies the entql int predicate on the response_status field.
+func (f *IdempotencyKeyFilter) WhereResponseStatus(p entql.IntP) {
+	f.Where(p.Field(idempotencykey.FieldResponseStatus))
+}
+
+// WhereResponseBody applies the entql []byte predicate on the response_body field.
+func (f *IdempotencyKeyFilter) WhereResponseBody(p entql.BytesP) {
+	f.Where(p.Field(idempotencykey.FieldResponseBody))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *IdempotencyKeyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(idempotencykey.FieldCreatedAt))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *IdempotencyKeyFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(idempotencykey.FieldExpiresAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (tq *TransactionQuery) addPredicate(pred func(s *sql.Selector)) {
 	tq.predicates = append(tq.predicates, pred)
@@ -68,7 +246,7 @@ type TransactionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TransactionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -93,3 +271,23 @@ func (f *TransactionFilter) WhereAmountInUsd(p entql.Float64P) {
 func (f *TransactionFilter) WhereDescription(p entql.StringP) {
 	f.Where(p.Field(transaction.FieldDescription))
 }
+
+// WhereOriginalAmount applies the entql float64 predicate on the original_amount field.
+func (f *TransactionFilter) WhereOriginalAmount(p entql.Float64P) {
+	f.Where(p.Field(transaction.FieldOriginalAmount))
+}
+
+// WhereOriginalCurrency applies the entql string predicate on the original_currency field.
+func (f *TransactionFilter) WhereOriginalCurrency(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldOriginalCurrency))
+}
+
+// WhereExchangeRate applies the entql float64 predicate on the exchange_rate field.
+func (f *TransactionFilter) WhereExchangeRate(p entql.Float64P) {
+	f.Where(p.Field(transaction.FieldExchangeRate))
+}
+
+// WhereExchangeRateDate applies the entql time.Time predicate on the exchange_rate_date field.
+func (f *TransactionFilter) WhereExchangeRateDate(p entql.TimeP) {
+	f.Where(p.Field(transaction.FieldExchangeRateDate))
+}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate is the model entity for the ExchangeRate schema.
+type ExchangeRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// CountryCurrencyDesc holds the value of the "country_currency_desc" field.
+	CountryCurrencyDesc string `json:"country_currency_desc,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// RecordDate holds the value of the "record_date" field.
+	RecordDate   time.Time `json:"record_date,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldRate:
+			values[i] = new(decimal.Decimal)
+		case exchangerate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case exchangerate.FieldCountry, exchangerate.FieldCurrency, exchangerate.FieldCountryCurrencyDesc:
+			values[i] = new(sql.NullString)
+		case exchangerate.FieldRecordDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeRate fields.
+func (er *ExchangeRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			er.ID = int(value.Int64)
+		case exchangerate.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				er.Country = value.String
+			}
+		case exchangerate.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				er.Currency = value.String
+			}
+		case exchangerate.FieldCountryCurrencyDesc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country_currency_desc", values[i])
+			} else if value.Valid {
+				er.CountryCurrencyDesc = value.String
+			}
+		case exchangerate.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				er.Rate = *value
+			}
+		case exchangerate.FieldRecordDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field record_date", values[i])
+			} else if value.Valid {
+				er.RecordDate = value.Time
+			}
+		default:
+			er.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExchangeRate.
+// This includes values selected through modifiers, order, etc.
+func (er *ExchangeRate) Value(name string) (ent.Value, error) {
+	return er.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExchangeRate.
+// Note that you need to call ExchangeRate.Unwrap() before calling this method if this ExchangeRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (er *ExchangeRate) Update() *ExchangeRateUpdateOne {
+	return NewExchangeRateClient(er.config).UpdateOne(er)
+}
+
+// Unwrap unwraps the ExchangeRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (er *ExchangeRate) Unwrap() *ExchangeRate {
+	_tx, ok := er.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeRate is not a transactional entity")
+	}
+	er.config.driver = _tx.drv
+	return er
+}
+
+// String implements the fmt.Stringer.
+func (er *ExchangeRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", er.ID))
+	builder.WriteString("country=")
+	builder.WriteString(er.Country)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(er.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("country_currency_desc=")
+	builder.WriteString(er.CountryCurrencyDesc)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", er.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("record_date=")
+	builder.WriteString(er.RecordDate.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeRates is a parsable slice of ExchangeRate.
+type ExchangeRates []*ExchangeRate
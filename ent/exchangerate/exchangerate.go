@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the exchangerate type in the database.
+	Label = "exchange_rate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldCountryCurrencyDesc holds the string denoting the country_currency_desc field in the database.
+	FieldCountryCurrencyDesc = "country_currency_desc"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldRecordDate holds the string denoting the record_date field in the database.
+	FieldRecordDate = "record_date"
+	// Table holds the table name of the exchangerate in the database.
+	Table = "exchange_rates"
+)
+
+// Columns holds all SQL columns for exchangerate fields.
+var Columns = []string{
+	FieldID,
+	FieldCountry,
+	FieldCurrency,
+	FieldCountryCurrencyDesc,
+	FieldRate,
+	FieldRecordDate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the ExchangeRate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByCountryCurrencyDesc orders the results by the country_currency_desc field.
+func ByCountryCurrencyDesc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountryCurrencyDesc, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByRecordDate orders the results by the record_date field.
+func ByRecordDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordDate, opts...).ToFunc()
+}
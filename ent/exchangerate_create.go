@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateCreate is the builder for creating a ExchangeRate entity.
+type ExchangeRateCreate struct {
+	config
+	mutation *ExchangeRateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCountry sets the "country" field.
+func (erc *ExchangeRateCreate) SetCountry(s string) *ExchangeRateCreate {
+	erc.mutation.SetCountry(s)
+	return erc
+}
+
+// SetCurrency sets the "currency" field.
+func (erc *ExchangeRateCreate) SetCurrency(s string) *ExchangeRateCreate {
+	erc.mutation.SetCurrency(s)
+	return erc
+}
+
+// SetCountryCurrencyDesc sets the "country_currency_desc" field.
+func (erc *ExchangeRateCreate) SetCountryCurrencyDesc(s string) *ExchangeRateCreate {
+	erc.mutation.SetCountryCurrencyDesc(s)
+	return erc
+}
+
+// SetRate sets the "rate" field.
+func (erc *ExchangeRateCreate) SetRate(d decimal.Decimal) *ExchangeRateCreate {
+	erc.mutation.SetRate(d)
+	return erc
+}
+
+// SetRecordDate sets the "record_date" field.
+func (erc *ExchangeRateCreate) SetRecordDate(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetRecordDate(t)
+	return erc
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (erc *ExchangeRateCreate) Mutation() *ExchangeRateMutation {
+	return erc.mutation
+}
+
+// Save creates the ExchangeRate in the database.
+func (erc *ExchangeRateCreate) Save(ctx context.Context) (*ExchangeRate, error) {
+	return withHooks(ctx, erc.sqlSave, erc.mutation, erc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (erc *ExchangeRateCreate) SaveX(ctx context.Context) *ExchangeRate {
+	v, err := erc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erc *ExchangeRateCreate) Exec(ctx context.Context) error {
+	_, err := erc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erc *ExchangeRateCreate) ExecX(ctx context.Context) {
+	if err := erc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (erc *ExchangeRateCreate) check() error {
+	if _, ok := erc.mutation.Country(); !ok {
+		return &ValidationError{Name: "country", err: errors.New(`ent: missing required field "ExchangeRate.country"`)}
+	}
+	if _, ok := erc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "ExchangeRate.currency"`)}
+	}
+	if _, ok := erc.mutation.CountryCurrencyDesc(); !ok {
+		return &ValidationError{Name: "country_currency_desc", err: errors.New(`ent: missing required field "ExchangeRate.country_currency_desc"`)}
+	}
+	if _, ok := erc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ExchangeRate.rate"`)}
+	}
+	if _, ok := erc.mutation.RecordDate(); !ok {
+		return &ValidationError{Name: "record_date", err: errors.New(`ent: missing required field "ExchangeRate.record_date"`)}
+	}
+	return nil
+}
+
+func (erc *ExchangeRateCreate) sqlSave(ctx context.Context) (*ExchangeRate, error) {
+	if err := erc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := erc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, erc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	erc.mutation.id = &_node.ID
+	erc.mutation.done = true
+	return _node, nil
+}
+
+func (erc *ExchangeRateCreate) createSpec() (*ExchangeRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExchangeRate{config: erc.config}
+		_spec = sqlgraph.NewCreateSpec(exchangerate.Table, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = erc.conflict
+	if value, ok := erc.mutation.Country(); ok {
+		_spec.SetField(exchangerate.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := erc.mutation.Currency(); ok {
+		_spec.SetField(exchangerate.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := erc.mutation.CountryCurrencyDesc(); ok {
+		_spec.SetField(exchangerate.FieldCountryCurrencyDesc, field.TypeString, value)
+		_node.CountryCurrencyDesc = value
+	}
+	if value, ok := erc.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := erc.mutation.RecordDate(); ok {
+		_spec.SetField(exchangerate.FieldRecordDate, field.TypeTime, value)
+		_node.RecordDate = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeRate.Create().
+//		SetCountry(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeRateUpsert) {
+//			SetCountry(v+v).
+//		}).
+//		Exec(ctx)
+func (erc *ExchangeRateCreate) OnConflict(opts ...sql.ConflictOption) *ExchangeRateUpsertOne {
+	erc.conflict = opts
+	return &ExchangeRateUpsertOne{
+		create: erc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (erc *ExchangeRateCreate) OnConflictColumns(columns ...string) *ExchangeRateUpsertOne {
+	erc.conflict = append(erc.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeRateUpsertOne{
+		create: erc,
+	}
+}
+
+type (
+	// ExchangeRateUpsertOne is the builder for "upsert"-ing
+	//  one ExchangeRate node.
+	ExchangeRateUpsertOne struct {
+		create *ExchangeRateCreate
+	}
+
+	// ExchangeRateUpsert is the "OnConflict" setter.
+	ExchangeRateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCountry sets the "country" field.
+func (u *ExchangeRateUpsert) SetCountry(v string) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldCountry, v)
+	return u
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateCountry() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldCountry)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ExchangeRateUpsert) SetCurrency(v string) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateCurrency() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldCurrency)
+	return u
+}
+
+// SetCountryCurrencyDesc sets the "country_currency_desc" field.
+func (u *ExchangeRateUpsert) SetCountryCurrencyDesc(v string) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldCountryCurrencyDesc, v)
+	return u
+}
+
+// UpdateCountryCurrencyDesc sets the "country_currency_desc" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateCountryCurrencyDesc() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldCountryCurrencyDesc)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *ExchangeRateUpsert) SetRate(v decimal.Decimal) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateRate() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldRate)
+	return u
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ExchangeRateUpsert) AddRate(v decimal.Decimal) *ExchangeRateUpsert {
+	u.Add(exchangerate.FieldRate, v)
+	return u
+}
+
+// SetRecordDate sets the "record_date" field.
+func (u *ExchangeRateUpsert) SetRecordDate(v time.Time) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldRecordDate, v)
+	return u
+}
+
+// UpdateRecordDate sets the "record_date" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateRecordDate() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldRecordDate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertOne) UpdateNewValues() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExchangeRateUpsertOne) Ignore() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeRateUpsertOne) DoNothing() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeRateCreate.OnConflict
+// documentation for more info.
+func (u *ExchangeRateUpsertOne) Update(set func(*ExchangeRateUpsert)) *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCountry sets the "country" field.
+func (u *ExchangeRateUpsertOne) SetCountry(v string) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateCountry() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ExchangeRateUpsertOne) SetCurrency(v string) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateCurrency() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetCountryCurrencyDesc sets the "country_currency_desc" field.
+func (u *ExchangeRateUpsertOne) SetCountryCurrencyDesc(v string) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetCountryCurrencyDesc(v)
+	})
+}
+
+// UpdateCountryCurrencyDesc sets the "country_currency_desc" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateCountryCurrencyDesc() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateCountryCurrencyDesc()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ExchangeRateUpsertOne) SetRate(v decimal.Decimal) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ExchangeRateUpsertOne) AddRate(v decimal.Decimal) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateRate() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetRecordDate sets the "record_date" field.
+func (u *ExchangeRateUpsertOne) SetRecordDate(v time.Time) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRecordDate(v)
+	})
+}
+
+// UpdateRecordDate sets the "record_date" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateRecordDate() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRecordDate()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeRateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeRateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeRateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExchangeRateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExchangeRateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExchangeRateCreateBulk is the builder for creating many ExchangeRate entities in bulk.
+type ExchangeRateCreateBulk struct {
+	config
+	err      error
+	builders []*ExchangeRateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExchangeRate entities in the database.
+func (ercb *ExchangeRateCreateBulk) Save(ctx context.Context) ([]*ExchangeRate, error) {
+	if ercb.err != nil {
+		return nil, ercb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ercb.builders))
+	nodes := make([]*ExchangeRate, len(ercb.builders))
+	mutators := make([]Mutator, len(ercb.builders))
+	for i := range ercb.builders {
+		func(i int, root context.Context) {
+			builder := ercb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExchangeRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ercb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ercb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ercb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ercb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) SaveX(ctx context.Context) []*ExchangeRate {
+	v, err := ercb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ercb *ExchangeRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ercb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) ExecX(ctx context.Context) {
+	if err := ercb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeRate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeRateUpsert) {
+//			SetCountry(v+v).
+//		}).
+//		Exec(ctx)
+func (ercb *ExchangeRateCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExchangeRateUpsertBulk {
+	ercb.conflict = opts
+	return &ExchangeRateUpsertBulk{
+		create: ercb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ercb *ExchangeRateCreateBulk) OnConflictColumns(columns ...string) *ExchangeRateUpsertBulk {
+	ercb.conflict = append(ercb.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeRateUpsertBulk{
+		create: ercb,
+	}
+}
+
+// ExchangeRateUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExchangeRate nodes.
+type ExchangeRateUpsertBulk struct {
+	create *ExchangeRateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertBulk) UpdateNewValues() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertBulk) Ignore() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeRateUpsertBulk) DoNothing() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeRateCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExchangeRateUpsertBulk) Update(set func(*ExchangeRateUpsert)) *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCountry sets the "country" field.
+func (u *ExchangeRateUpsertBulk) SetCountry(v string) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateCountry() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ExchangeRateUpsertBulk) SetCurrency(v string) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateCurrency() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetCountryCurrencyDesc sets the "country_currency_desc" field.
+func (u *ExchangeRateUpsertBulk) SetCountryCurrencyDesc(v string) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetCountryCurrencyDesc(v)
+	})
+}
+
+// UpdateCountryCurrencyDesc sets the "country_currency_desc" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateCountryCurrencyDesc() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateCountryCurrencyDesc()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ExchangeRateUpsertBulk) SetRate(v decimal.Decimal) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ExchangeRateUpsertBulk) AddRate(v decimal.Decimal) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateRate() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetRecordDate sets the "record_date" field.
+func (u *ExchangeRateUpsertBulk) SetRecordDate(v time.Time) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRecordDate(v)
+	})
+}
+
+// UpdateRecordDate sets the "record_date" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateRecordDate() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRecordDate()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeRateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExchangeRateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeRateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeRateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/eddie023/wex-tag/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateUpdate is the builder for updating ExchangeRate entities.
+type ExchangeRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (eru *ExchangeRateUpdate) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdate {
+	eru.mutation.Where(ps...)
+	return eru
+}
+
+// SetCountry sets the "country" field.
+func (eru *ExchangeRateUpdate) SetCountry(s string) *ExchangeRateUpdate {
+	eru.mutation.SetCountry(s)
+	return eru
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableCountry(s *string) *ExchangeRateUpdate {
+	if s != nil {
+		eru.SetCountry(*s)
+	}
+	return eru
+}
+
+// SetCurrency sets the "currency" field.
+func (eru *ExchangeRateUpdate) SetCurrency(s string) *ExchangeRateUpdate {
+	eru.mutation.SetCurrency(s)
+	return eru
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableCurrency(s *string) *ExchangeRateUpdate {
+	if s != nil {
+		eru.SetCurrency(*s)
+	}
+	return eru
+}
+
+// SetCountryCurrencyDesc sets the "country_currency_desc" field.
+func (eru *ExchangeRateUpdate) SetCountryCurrencyDesc(s string) *ExchangeRateUpdate {
+	eru.mutation.SetCountryCurrencyDesc(s)
+	return eru
+}
+
+// SetNillableCountryCurrencyDesc sets the "country_currency_desc" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableCountryCurrencyDesc(s *string) *ExchangeRateUpdate {
+	if s != nil {
+		eru.SetCountryCurrencyDesc(*s)
+	}
+	return eru
+}
+
+// SetRate sets the "rate" field.
+func (eru *ExchangeRateUpdate) SetRate(d decimal.Decimal) *ExchangeRateUpdate {
+	eru.mutation.ResetRate()
+	eru.mutation.SetRate(d)
+	return eru
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableRate(d *decimal.Decimal) *ExchangeRateUpdate {
+	if d != nil {
+		eru.SetRate(*d)
+	}
+	return eru
+}
+
+// AddRate adds d to the "rate" field.
+func (eru *ExchangeRateUpdate) AddRate(d decimal.Decimal) *ExchangeRateUpdate {
+	eru.mutation.AddRate(d)
+	return eru
+}
+
+// SetRecordDate sets the "record_date" field.
+func (eru *ExchangeRateUpdate) SetRecordDate(t time.Time) *ExchangeRateUpdate {
+	eru.mutation.SetRecordDate(t)
+	return eru
+}
+
+// SetNillableRecordDate sets the "record_date" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableRecordDate(t *time.Time) *ExchangeRateUpdate {
+	if t != nil {
+		eru.SetRecordDate(*t)
+	}
+	return eru
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eru *ExchangeRateUpdate) Mutation() *ExchangeRateMutation {
+	return eru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eru *ExchangeRateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eru.sqlSave, eru.mutation, eru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := eru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eru *ExchangeRateUpdate) Exec(ctx context.Context) error {
+	_, err := eru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) ExecX(ctx context.Context) {
+	if err := eru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eru *ExchangeRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	if ps := eru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eru.mutation.Country(); ok {
+		_spec.SetField(exchangerate.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := eru.mutation.Currency(); ok {
+		_spec.SetField(exchangerate.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := eru.mutation.CountryCurrencyDesc(); ok {
+		_spec.SetField(exchangerate.FieldCountryCurrencyDesc, field.TypeString, value)
+	}
+	if value, ok := eru.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := eru.mutation.AddedRate(); ok {
+		_spec.AddField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := eru.mutation.RecordDate(); ok {
+		_spec.SetField(exchangerate.FieldRecordDate, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eru.mutation.done = true
+	return n, nil
+}
+
+// ExchangeRateUpdateOne is the builder for updating a single ExchangeRate entity.
+type ExchangeRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// SetCountry sets the "country" field.
+func (eruo *ExchangeRateUpdateOne) SetCountry(s string) *ExchangeRateUpdateOne {
+	eruo.mutation.SetCountry(s)
+	return eruo
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableCountry(s *string) *ExchangeRateUpdateOne {
+	if s != nil {
+		eruo.SetCountry(*s)
+	}
+	return eruo
+}
+
+// SetCurrency sets the "currency" field.
+func (eruo *ExchangeRateUpdateOne) SetCurrency(s string) *ExchangeRateUpdateOne {
+	eruo.mutation.SetCurrency(s)
+	return eruo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableCurrency(s *string) *ExchangeRateUpdateOne {
+	if s != nil {
+		eruo.SetCurrency(*s)
+	}
+	return eruo
+}
+
+// SetCountryCurrencyDesc sets the "country_currency_desc" field.
+func (eruo *ExchangeRateUpdateOne) SetCountryCurrencyDesc(s string) *ExchangeRateUpdateOne {
+	eruo.mutation.SetCountryCurrencyDesc(s)
+	return eruo
+}
+
+// SetNillableCountryCurrencyDesc sets the "country_currency_desc" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableCountryCurrencyDesc(s *string) *ExchangeRateUpdateOne {
+	if s != nil {
+		eruo.SetCountryCurrencyDesc(*s)
+	}
+	return eruo
+}
+
+// SetRate sets the "rate" field.
+func (eruo *ExchangeRateUpdateOne) SetRate(d decimal.Decimal) *ExchangeRateUpdateOne {
+	eruo.mutation.ResetRate()
+	eruo.mutation.SetRate(d)
+	return eruo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableRate(d *decimal.Decimal) *ExchangeRateUpdateOne {
+	if d != nil {
+		eruo.SetRate(*d)
+	}
+	return eruo
+}
+
+// AddRate adds d to the "rate" field.
+func (eruo *ExchangeRateUpdateOne) AddRate(d decimal.Decimal) *ExchangeRateUpdateOne {
+	eruo.mutation.AddRate(d)
+	return eruo
+}
+
+// SetRecordDate sets the "record_date" field.
+func (eruo *ExchangeRateUpdateOne) SetRecordDate(t time.Time) *ExchangeRateUpdateOne {
+	eruo.mutation.SetRecordDate(t)
+	return eruo
+}
+
+// SetNillableRecordDate sets the "record_date" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableRecordDate(t *time.Time) *ExchangeRateUpdateOne {
+	if t != nil {
+		eruo.SetRecordDate(*t)
+	}
+	return eruo
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eruo *ExchangeRateUpdateOne) Mutation() *ExchangeRateMutation {
+	return eruo.mutation
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (eruo *ExchangeRateUpdateOne) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdateOne {
+	eruo.mutation.Where(ps...)
+	return eruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eruo *ExchangeRateUpdateOne) Select(field string, fields ...string) *ExchangeRateUpdateOne {
+	eruo.fields = append([]string{field}, fields...)
+	return eruo
+}
+
+// Save executes the query and returns the updated ExchangeRate entity.
+func (eruo *ExchangeRateUpdateOne) Save(ctx context.Context) (*ExchangeRate, error) {
+	return withHooks(ctx, eruo.sqlSave, eruo.mutation, eruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) SaveX(ctx context.Context) *ExchangeRate {
+	node, err := eruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eruo *ExchangeRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := eruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) ExecX(ctx context.Context) {
+	if err := eruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eruo *ExchangeRateUpdateOne) sqlSave(ctx context.Context) (_node *ExchangeRate, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	id, ok := eruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExchangeRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exchangerate.FieldID)
+		for _, f := range fields {
+			if !exchangerate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exchangerate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eruo.mutation.Country(); ok {
+		_spec.SetField(exchangerate.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := eruo.mutation.Currency(); ok {
+		_spec.SetField(exchangerate.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := eruo.mutation.CountryCurrencyDesc(); ok {
+		_spec.SetField(exchangerate.FieldCountryCurrencyDesc, field.TypeString, value)
+	}
+	if value, ok := eruo.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := eruo.mutation.AddedRate(); ok {
+		_spec.AddField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := eruo.mutation.RecordDate(); ok {
+		_spec.SetField(exchangerate.FieldRecordDate, field.TypeTime, value)
+	}
+	_node = &ExchangeRate{config: eruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eruo.mutation.done = true
+	return _node, nil
+}
@@ -6,4 +6,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = `{"Schema":"github.com/eddie023/wex-tag/ent/schema","Package":"github.com/eddie023/wex-tag/ent","Schemas":[{"name":"Transaction","config":{"Table":""},"fields":[{"name":"id","type":{"Type":4,"Ident":"uuid.UUID","PkgPath":"github.com/google/uuid","PkgName":"uuid","Nillable":false,"RType":{"Name":"UUID","Ident":"uuid.UUID","Kind":17,"PkgPath":"github.com/google/uuid","Methods":{"ClockSequence":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Domain":{"In":[],"Out":[{"Name":"Domain","Ident":"uuid.Domain","Kind":8,"PkgPath":"github.com/google/uuid","Methods":null}]},"ID":{"In":[],"Out":[{"Name":"uint32","Ident":"uint32","Kind":10,"PkgPath":"","Methods":null}]},"MarshalBinary":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalText":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"NodeID":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}]},"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Time":{"In":[],"Out":[{"Name":"Time","Ident":"uuid.Time","Kind":6,"PkgPath":"github.com/google/uuid","Methods":null}]},"URN":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"UnmarshalBinary":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalText":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Variant":{"In":[],"Out":[{"Name":"Variant","Ident":"uuid.Variant","Kind":8,"PkgPath":"github.com/google/uuid","Methods":null}]},"Version":{"In":[],"Out":[{"Name":"Version","Ident":"uuid.Version","Kind":8,"PkgPath":"github.com/google/uuid","Methods":null}]}}}},"default":true,"default_kind":19,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"date","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"amount_in_usd","type":{"Type":20,"Ident":"decimal.Decimal","PkgPath":"github.com/shopspring/decimal","PkgName":"decimal","Nillable":false,"RType":{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":{"Abs":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Add":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Atan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"BigFloat":{"In":[],"Out":[{"Name":"","Ident":"*big.Float","Kind":22,"PkgPath":"","Methods":null}]},"BigInt":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"Ceil":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cmp":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Coefficient":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"CoefficientInt64":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"Copy":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cos":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Div":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"DivRound":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Equal":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equals":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"ExpHullAbrham":{"In":[{"Name":"uint32","Ident":"uint32","Kind":10,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ExpTaylor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Exponent":{"In":[],"Out":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}]},"Float64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null},{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Floor":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"GobDecode":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GobEncode":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GreaterThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"GreaterThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"InexactFloat64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null}]},"IntPart":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"IsInteger":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsNegative":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsPositive":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"MarshalBinary":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalJSON":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalText":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Mod":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Mul":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Neg":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"NumDigits":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Pow":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"QuoRem":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Rat":{"In":[],"Out":[{"Name":"","Ident":"*big.Rat","Kind":22,"PkgPath":"","Methods":null}]},"Round":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCeil":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundDown":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundFloor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundUp":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Shift":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Sign":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Sin":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixed":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringScaled":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Sub":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Tan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Truncate":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"UnmarshalBinary":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalJSON":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalText":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"postgres":"numeric"}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":50,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}}]}],"Features":["privacy","entql","schema/snapshot","sql/versioned-migration"]}`
+const Schema = `{"Schema":"github.com/eddie023/wex-tag/ent/schema","Package":"github.com/eddie023/wex-tag/ent","Schemas":[{"name":"ExchangeRate","config":{"Table":""},"fields":[{"name":"country","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"currency","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"country_currency_desc","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"rate","type":{"Type":20,"Ident":"decimal.Decimal","PkgPath":"github.com/shopspring/decimal","PkgName":"decimal","Nillable":false,"RType":{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":{"Abs":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Add":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Atan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"BigFloat":{"In":[],"Out":[{"Name":"","Ident":"*big.Float","Kind":22,"PkgPath":"","Methods":null}]},"BigInt":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"Ceil":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cmp":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Coefficient":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"CoefficientInt64":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"Copy":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cos":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Div":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"DivRound":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Equal":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equals":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"ExpHullAbrham":{"In":[{"Name":"uint32","Ident":"uint32","Kind":10,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ExpTaylor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Exponent":{"In":[],"Out":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}]},"Float64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null},{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Floor":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"GobDecode":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GobEncode":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GreaterThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"GreaterThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"InexactFloat64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null}]},"IntPart":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"IsInteger":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsNegative":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsPositive":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"MarshalBinary":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalJSON":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalText":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Mod":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Mul":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Neg":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"NumDigits":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Pow":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"QuoRem":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Rat":{"In":[],"Out":[{"Name":"","Ident":"*big.Rat","Kind":22,"PkgPath":"","Methods":null}]},"Round":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCeil":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundDown":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundFloor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundUp":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Shift":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Sign":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Sin":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixed":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringScaled":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Sub":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Tan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Truncate":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"UnmarshalBinary":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalJSON":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalText":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"postgres":"numeric"}},{"name":"record_date","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"postgres":"date"}}],"indexes":[{"unique":true,"fields":["country_currency_desc","record_date"]}]},{"name":"IdempotencyKey","config":{"Table":""},"fields":[{"name":"key","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"request_hash","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"response_status","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0}},{"name":"response_body","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"created_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0}},{"name":"expires_at","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"position":{"Index":5,"MixedIn":false,"MixinIndex":0}}],"indexes":[{"fields":["expires_at"]}]},{"name":"Transaction","config":{"Table":""},"fields":[{"name":"id","type":{"Type":4,"Ident":"uuid.UUID","PkgPath":"github.com/google/uuid","PkgName":"uuid","Nillable":false,"RType":{"Name":"UUID","Ident":"uuid.UUID","Kind":17,"PkgPath":"github.com/google/uuid","Methods":{"ClockSequence":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Domain":{"In":[],"Out":[{"Name":"Domain","Ident":"uuid.Domain","Kind":8,"PkgPath":"github.com/google/uuid","Methods":null}]},"ID":{"In":[],"Out":[{"Name":"uint32","Ident":"uint32","Kind":10,"PkgPath":"","Methods":null}]},"MarshalBinary":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalText":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"NodeID":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}]},"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Time":{"In":[],"Out":[{"Name":"Time","Ident":"uuid.Time","Kind":6,"PkgPath":"github.com/google/uuid","Methods":null}]},"URN":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"UnmarshalBinary":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalText":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Variant":{"In":[],"Out":[{"Name":"Variant","Ident":"uuid.Variant","Kind":8,"PkgPath":"github.com/google/uuid","Methods":null}]},"Version":{"In":[],"Out":[{"Name":"Version","Ident":"uuid.Version","Kind":8,"PkgPath":"github.com/google/uuid","Methods":null}]}}}},"default":true,"default_kind":19,"position":{"Index":0,"MixedIn":false,"MixinIndex":0}},{"name":"date","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"default":true,"default_kind":19,"position":{"Index":1,"MixedIn":false,"MixinIndex":0}},{"name":"amount_in_usd","type":{"Type":20,"Ident":"decimal.Decimal","PkgPath":"github.com/shopspring/decimal","PkgName":"decimal","Nillable":false,"RType":{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":{"Abs":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Add":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Atan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"BigFloat":{"In":[],"Out":[{"Name":"","Ident":"*big.Float","Kind":22,"PkgPath":"","Methods":null}]},"BigInt":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"Ceil":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cmp":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Coefficient":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"CoefficientInt64":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"Copy":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cos":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Div":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"DivRound":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Equal":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equals":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"ExpHullAbrham":{"In":[{"Name":"uint32","Ident":"uint32","Kind":10,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ExpTaylor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Exponent":{"In":[],"Out":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}]},"Float64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null},{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Floor":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"GobDecode":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GobEncode":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GreaterThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"GreaterThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"InexactFloat64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null}]},"IntPart":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"IsInteger":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsNegative":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsPositive":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"MarshalBinary":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalJSON":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalText":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Mod":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Mul":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Neg":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"NumDigits":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Pow":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"QuoRem":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Rat":{"In":[],"Out":[{"Name":"","Ident":"*big.Rat","Kind":22,"PkgPath":"","Methods":null}]},"Round":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCeil":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundDown":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundFloor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundUp":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Shift":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Sign":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Sin":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixed":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringScaled":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Sub":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Tan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Truncate":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"UnmarshalBinary":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalJSON":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalText":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"postgres":"numeric"}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":50,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0}},{"name":"original_amount","type":{"Type":20,"Ident":"decimal.Decimal","PkgPath":"github.com/shopspring/decimal","PkgName":"decimal","Nillable":false,"RType":{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":{"Abs":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Add":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Atan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"BigFloat":{"In":[],"Out":[{"Name":"","Ident":"*big.Float","Kind":22,"PkgPath":"","Methods":null}]},"BigInt":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"Ceil":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cmp":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Coefficient":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"CoefficientInt64":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"Copy":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cos":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Div":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"DivRound":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Equal":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equals":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"ExpHullAbrham":{"In":[{"Name":"uint32","Ident":"uint32","Kind":10,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ExpTaylor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Exponent":{"In":[],"Out":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}]},"Float64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null},{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Floor":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"GobDecode":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GobEncode":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GreaterThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"GreaterThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"InexactFloat64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null}]},"IntPart":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"IsInteger":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsNegative":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsPositive":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"MarshalBinary":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalJSON":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalText":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Mod":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Mul":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Neg":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"NumDigits":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Pow":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"QuoRem":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Rat":{"In":[],"Out":[{"Name":"","Ident":"*big.Rat","Kind":22,"PkgPath":"","Methods":null}]},"Round":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCeil":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundDown":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundFloor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundUp":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Shift":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Sign":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Sin":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixed":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringScaled":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Sub":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Tan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Truncate":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"UnmarshalBinary":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalJSON":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalText":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"postgres":"numeric"}},{"name":"original_currency","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":3,"nillable":true,"optional":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0}},{"name":"exchange_rate","type":{"Type":20,"Ident":"decimal.Decimal","PkgPath":"github.com/shopspring/decimal","PkgName":"decimal","Nillable":false,"RType":{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":{"Abs":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Add":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Atan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"BigFloat":{"In":[],"Out":[{"Name":"","Ident":"*big.Float","Kind":22,"PkgPath":"","Methods":null}]},"BigInt":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"Ceil":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cmp":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Coefficient":{"In":[],"Out":[{"Name":"","Ident":"*big.Int","Kind":22,"PkgPath":"","Methods":null}]},"CoefficientInt64":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"Copy":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Cos":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Div":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"DivRound":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Equal":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equals":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"ExpHullAbrham":{"In":[{"Name":"uint32","Ident":"uint32","Kind":10,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ExpTaylor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Exponent":{"In":[],"Out":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}]},"Float64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null},{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Floor":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"GobDecode":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GobEncode":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"GreaterThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"GreaterThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"InexactFloat64":{"In":[],"Out":[{"Name":"float64","Ident":"float64","Kind":14,"PkgPath":"","Methods":null}]},"IntPart":{"In":[],"Out":[{"Name":"int64","Ident":"int64","Kind":6,"PkgPath":"","Methods":null}]},"IsInteger":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsNegative":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsPositive":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThan":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"LessThanOrEqual":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"MarshalBinary":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalJSON":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"MarshalText":{"In":[],"Out":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Mod":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Mul":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Neg":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"NumDigits":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Pow":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"QuoRem":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null},{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Rat":{"In":[],"Out":[{"Name":"","Ident":"*big.Rat","Kind":22,"PkgPath":"","Methods":null}]},"Round":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundCeil":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundDown":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundFloor":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"RoundUp":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Shift":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Sign":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Sin":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixed":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedBank":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringFixedCash":{"In":[{"Name":"uint8","Ident":"uint8","Kind":8,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"StringScaled":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Sub":{"In":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Tan":{"In":[],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"Truncate":{"In":[{"Name":"int32","Ident":"int32","Kind":5,"PkgPath":"","Methods":null}],"Out":[{"Name":"Decimal","Ident":"decimal.Decimal","Kind":25,"PkgPath":"github.com/shopspring/decimal","Methods":null}]},"UnmarshalBinary":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalJSON":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"UnmarshalText":{"In":[{"Name":"","Ident":"[]uint8","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"schema_type":{"postgres":"numeric"}},{"name":"exchange_rate_date","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"schema_type":{"postgres":"date"}}]}],"Features":["privacy","entql","schema/snapshot","sql/versioned-migration","sql/upsert"]}`
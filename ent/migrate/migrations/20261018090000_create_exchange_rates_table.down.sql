@@ -0,0 +1,4 @@
+-- reverse: create index "exchangerate_country_currency_desc_record_date" to table: "exchange_rates"
+DROP INDEX "exchangerate_country_currency_desc_record_date";
+-- reverse: create "exchange_rates" table
+DROP TABLE "exchange_rates";
@@ -0,0 +1,4 @@
+-- create "exchange_rates" table
+CREATE TABLE "exchange_rates" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "country" character varying NOT NULL, "currency" character varying NOT NULL, "country_currency_desc" character varying NOT NULL, "rate" numeric NOT NULL, "record_date" date NOT NULL, PRIMARY KEY ("id"));
+-- create index "exchangerate_country_currency_desc_record_date" to table: "exchange_rates"
+CREATE UNIQUE INDEX "exchangerate_country_currency_desc_record_date" ON "exchange_rates" ("country_currency_desc", "record_date");
@@ -0,0 +1,6 @@
+-- reverse: create index "idempotencykey_expires_at" to table: "idempotency_keys"
+DROP INDEX "idempotencykey_expires_at";
+-- reverse: create index "idempotency_keys_key_key" to table: "idempotency_keys"
+DROP INDEX "idempotency_keys_key_key";
+-- reverse: create "idempotency_keys" table
+DROP TABLE "idempotency_keys";
@@ -0,0 +1,2 @@
+-- reverse: modify "transactions" table
+ALTER TABLE "transactions" DROP COLUMN "exchange_rate_date", DROP COLUMN "exchange_rate", DROP COLUMN "original_currency", DROP COLUMN "original_amount";
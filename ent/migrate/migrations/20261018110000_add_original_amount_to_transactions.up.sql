@@ -0,0 +1,2 @@
+-- modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "original_amount" numeric NULL, ADD COLUMN "original_currency" character varying(3) NULL, ADD COLUMN "exchange_rate" numeric NULL, ADD COLUMN "exchange_rate_date" date NULL;
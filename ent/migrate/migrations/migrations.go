@@ -2,6 +2,8 @@ package migrations
 
 import (
 	"embed"
+	"errors"
+	"io/fs"
 
 	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
@@ -13,3 +15,29 @@ var migrationFiles embed.FS
 func MigrationFS() (source.Driver, error) {
 	return iofs.New(migrationFiles, ".")
 }
+
+// LatestVersion returns the version of the most recent embedded migration.
+func LatestVersion() (uint, error) {
+	d, err := MigrationFS()
+	if err != nil {
+		return 0, err
+	}
+	defer d.Close()
+
+	version, err := d.First()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := d.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		version = next
+	}
+}
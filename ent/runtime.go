@@ -5,6 +5,7 @@ package ent
 import (
 	"time"
 
+	"github.com/eddie023/wex-tag/ent/idempotencykey"
 	"github.com/eddie023/wex-tag/ent/schema"
 	"github.com/eddie023/wex-tag/ent/transaction"
 	"github.com/google/uuid"
@@ -14,6 +15,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = idempotencykeyDescKey.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[4].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
 	transactionFields := schema.Transaction{}.Fields()
 	_ = transactionFields
 	// transactionDescDate is the schema descriptor for date field.
@@ -24,6 +35,10 @@ func init() {
 	transactionDescDescription := transactionFields[3].Descriptor()
 	// transaction.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	transaction.DescriptionValidator = transactionDescDescription.Validators[0].(func(string) error)
+	// transactionDescOriginalCurrency is the schema descriptor for original_currency field.
+	transactionDescOriginalCurrency := transactionFields[5].Descriptor()
+	// transaction.OriginalCurrencyValidator is a validator for the "original_currency" field. It is called by the builders before save.
+	transaction.OriginalCurrencyValidator = transactionDescOriginalCurrency.Validators[0].(func(string) error)
 	// transactionDescID is the schema descriptor for id field.
 	transactionDescID := transactionFields[0].Descriptor()
 	// transaction.DefaultID holds the default value on creation for the id field.
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity.
+// It is a local copy of the Treasury Reporting Rates of Exchange records.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("country"),
+		field.String("currency"),
+		// country and currency joined by '-' exactly as returned by the treasury API. e.g. 'United Kingdom-Pound'
+		field.String("country_currency_desc"),
+		field.Float("rate").GoType(decimal.Decimal{}).SchemaType(map[string]string{
+			dialect.Postgres: "numeric",
+		}),
+		field.Time("record_date").SchemaType(map[string]string{
+			dialect.Postgres: "date",
+		}),
+	}
+}
+
+// Edges of the ExchangeRate.
+func (ExchangeRate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ExchangeRate.
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		// there can only be a single rate for a currency on given record date
+		index.Fields("country_currency_desc", "record_date").Unique(),
+	}
+}
@@ -27,6 +27,19 @@ func (Transaction) Fields() []ent.Field {
 			dialect.Postgres: "numeric",
 		}),
 		field.String("description").MaxLen(50),
+		// purchases made in a foreign currency keep the amount as charged along with the treasury
+		// exchange rate which was used to derive amount_in_usd, none of them are set for USD purchases
+		field.Float("original_amount").GoType(decimal.Decimal{}).SchemaType(map[string]string{
+			dialect.Postgres: "numeric",
+		}).Optional().Nillable(),
+		// ISO 4217 currency code e.g. 'EUR'
+		field.String("original_currency").MaxLen(3).Optional().Nillable(),
+		field.Float("exchange_rate").GoType(decimal.Decimal{}).SchemaType(map[string]string{
+			dialect.Postgres: "numeric",
+		}).Optional().Nillable(),
+		field.Time("exchange_rate_date").SchemaType(map[string]string{
+			dialect.Postgres: "date",
+		}).Optional().Nillable(),
 	}
 }
 
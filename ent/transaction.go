@@ -24,8 +24,16 @@ type Transaction struct {
 	// AmountInUsd holds the value of the "amount_in_usd" field.
 	AmountInUsd decimal.Decimal `json:"amount_in_usd,omitempty"`
 	// Description holds the value of the "description" field.
-	Description  string `json:"description,omitempty"`
-	selectValues sql.SelectValues
+	Description string `json:"description,omitempty"`
+	// OriginalAmount holds the value of the "original_amount" field.
+	OriginalAmount *decimal.Decimal `json:"original_amount,omitempty"`
+	// OriginalCurrency holds the value of the "original_currency" field.
+	OriginalCurrency *string `json:"original_currency,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate *decimal.Decimal `json:"exchange_rate,omitempty"`
+	// ExchangeRateDate holds the value of the "exchange_rate_date" field.
+	ExchangeRateDate *time.Time `json:"exchange_rate_date,omitempty"`
+	selectValues     sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -33,11 +41,13 @@ func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case transaction.FieldOriginalAmount, transaction.FieldExchangeRate:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case transaction.FieldAmountInUsd:
 			values[i] = new(decimal.Decimal)
-		case transaction.FieldDescription:
+		case transaction.FieldDescription, transaction.FieldOriginalCurrency:
 			values[i] = new(sql.NullString)
-		case transaction.FieldDate:
+		case transaction.FieldDate, transaction.FieldExchangeRateDate:
 			values[i] = new(sql.NullTime)
 		case transaction.FieldID:
 			values[i] = new(uuid.UUID)
@@ -80,6 +90,34 @@ func (t *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Description = value.String
 			}
+		case transaction.FieldOriginalAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field original_amount", values[i])
+			} else if value.Valid {
+				t.OriginalAmount = new(decimal.Decimal)
+				*t.OriginalAmount = *value.S.(*decimal.Decimal)
+			}
+		case transaction.FieldOriginalCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_currency", values[i])
+			} else if value.Valid {
+				t.OriginalCurrency = new(string)
+				*t.OriginalCurrency = value.String
+			}
+		case transaction.FieldExchangeRate:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value.Valid {
+				t.ExchangeRate = new(decimal.Decimal)
+				*t.ExchangeRate = *value.S.(*decimal.Decimal)
+			}
+		case transaction.FieldExchangeRateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate_date", values[i])
+			} else if value.Valid {
+				t.ExchangeRateDate = new(time.Time)
+				*t.ExchangeRateDate = value.Time
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -124,6 +162,26 @@ func (t *Transaction) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(t.Description)
+	builder.WriteString(", ")
+	if v := t.OriginalAmount; v != nil {
+		builder.WriteString("original_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.OriginalCurrency; v != nil {
+		builder.WriteString("original_currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.ExchangeRate; v != nil {
+		builder.WriteString("exchange_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.ExchangeRateDate; v != nil {
+		builder.WriteString("exchange_rate_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
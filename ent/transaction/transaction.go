@@ -20,6 +20,14 @@ const (
 	FieldAmountInUsd = "amount_in_usd"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldOriginalAmount holds the string denoting the original_amount field in the database.
+	FieldOriginalAmount = "original_amount"
+	// FieldOriginalCurrency holds the string denoting the original_currency field in the database.
+	FieldOriginalCurrency = "original_currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldExchangeRateDate holds the string denoting the exchange_rate_date field in the database.
+	FieldExchangeRateDate = "exchange_rate_date"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
 )
@@ -30,6 +38,10 @@ var Columns = []string{
 	FieldDate,
 	FieldAmountInUsd,
 	FieldDescription,
+	FieldOriginalAmount,
+	FieldOriginalCurrency,
+	FieldExchangeRate,
+	FieldExchangeRateDate,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -47,6 +59,8 @@ var (
 	DefaultDate func() time.Time
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
+	// OriginalCurrencyValidator is a validator for the "original_currency" field. It is called by the builders before save.
+	OriginalCurrencyValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -73,3 +87,23 @@ func ByAmountInUsd(opts ...sql.OrderTermOption) OrderOption {
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
+
+// ByOriginalAmount orders the results by the original_amount field.
+func ByOriginalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalAmount, opts...).ToFunc()
+}
+
+// ByOriginalCurrency orders the results by the original_currency field.
+func ByOriginalCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalCurrency, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
+// ByExchangeRateDate orders the results by the exchange_rate_date field.
+func ByExchangeRateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRateDate, opts...).ToFunc()
+}
@@ -71,6 +71,26 @@ func Description(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldDescription, v))
 }
 
+// OriginalAmount applies equality check predicate on the "original_amount" field. It's identical to OriginalAmountEQ.
+func OriginalAmount(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalAmount, v))
+}
+
+// OriginalCurrency applies equality check predicate on the "original_currency" field. It's identical to OriginalCurrencyEQ.
+func OriginalCurrency(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalCurrency, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateDate applies equality check predicate on the "exchange_rate_date" field. It's identical to ExchangeRateDateEQ.
+func ExchangeRateDate(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRateDate, v))
+}
+
 // DateEQ applies the EQ predicate on the "date" field.
 func DateEQ(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldDate, v))
@@ -216,6 +236,231 @@ func DescriptionContainsFold(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// OriginalAmountEQ applies the EQ predicate on the "original_amount" field.
+func OriginalAmountEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalAmount, v))
+}
+
+// OriginalAmountNEQ applies the NEQ predicate on the "original_amount" field.
+func OriginalAmountNEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldOriginalAmount, v))
+}
+
+// OriginalAmountIn applies the In predicate on the "original_amount" field.
+func OriginalAmountIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldOriginalAmount, vs...))
+}
+
+// OriginalAmountNotIn applies the NotIn predicate on the "original_amount" field.
+func OriginalAmountNotIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldOriginalAmount, vs...))
+}
+
+// OriginalAmountGT applies the GT predicate on the "original_amount" field.
+func OriginalAmountGT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldOriginalAmount, v))
+}
+
+// OriginalAmountGTE applies the GTE predicate on the "original_amount" field.
+func OriginalAmountGTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldOriginalAmount, v))
+}
+
+// OriginalAmountLT applies the LT predicate on the "original_amount" field.
+func OriginalAmountLT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldOriginalAmount, v))
+}
+
+// OriginalAmountLTE applies the LTE predicate on the "original_amount" field.
+func OriginalAmountLTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldOriginalAmount, v))
+}
+
+// OriginalAmountIsNil applies the IsNil predicate on the "original_amount" field.
+func OriginalAmountIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldOriginalAmount))
+}
+
+// OriginalAmountNotNil applies the NotNil predicate on the "original_amount" field.
+func OriginalAmountNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldOriginalAmount))
+}
+
+// OriginalCurrencyEQ applies the EQ predicate on the "original_currency" field.
+func OriginalCurrencyEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyNEQ applies the NEQ predicate on the "original_currency" field.
+func OriginalCurrencyNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyIn applies the In predicate on the "original_currency" field.
+func OriginalCurrencyIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldOriginalCurrency, vs...))
+}
+
+// OriginalCurrencyNotIn applies the NotIn predicate on the "original_currency" field.
+func OriginalCurrencyNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldOriginalCurrency, vs...))
+}
+
+// OriginalCurrencyGT applies the GT predicate on the "original_currency" field.
+func OriginalCurrencyGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyGTE applies the GTE predicate on the "original_currency" field.
+func OriginalCurrencyGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyLT applies the LT predicate on the "original_currency" field.
+func OriginalCurrencyLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyLTE applies the LTE predicate on the "original_currency" field.
+func OriginalCurrencyLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyContains applies the Contains predicate on the "original_currency" field.
+func OriginalCurrencyContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyHasPrefix applies the HasPrefix predicate on the "original_currency" field.
+func OriginalCurrencyHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyHasSuffix applies the HasSuffix predicate on the "original_currency" field.
+func OriginalCurrencyHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyIsNil applies the IsNil predicate on the "original_currency" field.
+func OriginalCurrencyIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldOriginalCurrency))
+}
+
+// OriginalCurrencyNotNil applies the NotNil predicate on the "original_currency" field.
+func OriginalCurrencyNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldOriginalCurrency))
+}
+
+// OriginalCurrencyEqualFold applies the EqualFold predicate on the "original_currency" field.
+func OriginalCurrencyEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyContainsFold applies the ContainsFold predicate on the "original_currency" field.
+func OriginalCurrencyContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldOriginalCurrency, v))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateIsNil applies the IsNil predicate on the "exchange_rate" field.
+func ExchangeRateIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldExchangeRate))
+}
+
+// ExchangeRateNotNil applies the NotNil predicate on the "exchange_rate" field.
+func ExchangeRateNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldExchangeRate))
+}
+
+// ExchangeRateDateEQ applies the EQ predicate on the "exchange_rate_date" field.
+func ExchangeRateDateEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRateDate, v))
+}
+
+// ExchangeRateDateNEQ applies the NEQ predicate on the "exchange_rate_date" field.
+func ExchangeRateDateNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExchangeRateDate, v))
+}
+
+// ExchangeRateDateIn applies the In predicate on the "exchange_rate_date" field.
+func ExchangeRateDateIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExchangeRateDate, vs...))
+}
+
+// ExchangeRateDateNotIn applies the NotIn predicate on the "exchange_rate_date" field.
+func ExchangeRateDateNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExchangeRateDate, vs...))
+}
+
+// ExchangeRateDateGT applies the GT predicate on the "exchange_rate_date" field.
+func ExchangeRateDateGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExchangeRateDate, v))
+}
+
+// ExchangeRateDateGTE applies the GTE predicate on the "exchange_rate_date" field.
+func ExchangeRateDateGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExchangeRateDate, v))
+}
+
+// ExchangeRateDateLT applies the LT predicate on the "exchange_rate_date" field.
+func ExchangeRateDateLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExchangeRateDate, v))
+}
+
+// ExchangeRateDateLTE applies the LTE predicate on the "exchange_rate_date" field.
+func ExchangeRateDateLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExchangeRateDate, v))
+}
+
+// ExchangeRateDateIsNil applies the IsNil predicate on the "exchange_rate_date" field.
+func ExchangeRateDateIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldExchangeRateDate))
+}
+
+// ExchangeRateDateNotNil applies the NotNil predicate on the "exchange_rate_date" field.
+func ExchangeRateDateNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldExchangeRateDate))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Transaction) predicate.Transaction {
 	return predicate.Transaction(sql.AndPredicates(predicates...))
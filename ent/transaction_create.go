@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eddie023/wex-tag/ent/transaction"
@@ -20,6 +22,7 @@ type TransactionCreate struct {
 	config
 	mutation *TransactionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetDate sets the "date" field.
@@ -48,6 +51,62 @@ func (tc *TransactionCreate) SetDescription(s string) *TransactionCreate {
 	return tc
 }
 
+// SetOriginalAmount sets the "original_amount" field.
+func (tc *TransactionCreate) SetOriginalAmount(d decimal.Decimal) *TransactionCreate {
+	tc.mutation.SetOriginalAmount(d)
+	return tc
+}
+
+// SetNillableOriginalAmount sets the "original_amount" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableOriginalAmount(d *decimal.Decimal) *TransactionCreate {
+	if d != nil {
+		tc.SetOriginalAmount(*d)
+	}
+	return tc
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (tc *TransactionCreate) SetOriginalCurrency(s string) *TransactionCreate {
+	tc.mutation.SetOriginalCurrency(s)
+	return tc
+}
+
+// SetNillableOriginalCurrency sets the "original_currency" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableOriginalCurrency(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetOriginalCurrency(*s)
+	}
+	return tc
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (tc *TransactionCreate) SetExchangeRate(d decimal.Decimal) *TransactionCreate {
+	tc.mutation.SetExchangeRate(d)
+	return tc
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableExchangeRate(d *decimal.Decimal) *TransactionCreate {
+	if d != nil {
+		tc.SetExchangeRate(*d)
+	}
+	return tc
+}
+
+// SetExchangeRateDate sets the "exchange_rate_date" field.
+func (tc *TransactionCreate) SetExchangeRateDate(t time.Time) *TransactionCreate {
+	tc.mutation.SetExchangeRateDate(t)
+	return tc
+}
+
+// SetNillableExchangeRateDate sets the "exchange_rate_date" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableExchangeRateDate(t *time.Time) *TransactionCreate {
+	if t != nil {
+		tc.SetExchangeRateDate(*t)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TransactionCreate) SetID(u uuid.UUID) *TransactionCreate {
 	tc.mutation.SetID(u)
@@ -123,6 +182,11 @@ func (tc *TransactionCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
 		}
 	}
+	if v, ok := tc.mutation.OriginalCurrency(); ok {
+		if err := transaction.OriginalCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "original_currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.original_currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -154,6 +218,7 @@ func (tc *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node = &Transaction{config: tc.config}
 		_spec = sqlgraph.NewCreateSpec(transaction.Table, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = tc.conflict
 	if id, ok := tc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -170,14 +235,439 @@ func (tc *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := tc.mutation.OriginalAmount(); ok {
+		_spec.SetField(transaction.FieldOriginalAmount, field.TypeFloat64, value)
+		_node.OriginalAmount = &value
+	}
+	if value, ok := tc.mutation.OriginalCurrency(); ok {
+		_spec.SetField(transaction.FieldOriginalCurrency, field.TypeString, value)
+		_node.OriginalCurrency = &value
+	}
+	if value, ok := tc.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+		_node.ExchangeRate = &value
+	}
+	if value, ok := tc.mutation.ExchangeRateDate(); ok {
+		_spec.SetField(transaction.FieldExchangeRateDate, field.TypeTime, value)
+		_node.ExchangeRateDate = &value
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.Create().
+//		SetDate(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetDate(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TransactionCreate) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertOne {
+	tc.conflict = opts
+	return &TransactionUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TransactionCreate) OnConflictColumns(columns ...string) *TransactionUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TransactionUpsertOne is the builder for "upsert"-ing
+	//  one Transaction node.
+	TransactionUpsertOne struct {
+		create *TransactionCreate
+	}
+
+	// TransactionUpsert is the "OnConflict" setter.
+	TransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDate sets the "date" field.
+func (u *TransactionUpsert) SetDate(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateDate() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldDate)
+	return u
+}
+
+// SetAmountInUsd sets the "amount_in_usd" field.
+func (u *TransactionUpsert) SetAmountInUsd(v decimal.Decimal) *TransactionUpsert {
+	u.Set(transaction.FieldAmountInUsd, v)
+	return u
+}
+
+// UpdateAmountInUsd sets the "amount_in_usd" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateAmountInUsd() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldAmountInUsd)
+	return u
+}
+
+// AddAmountInUsd adds v to the "amount_in_usd" field.
+func (u *TransactionUpsert) AddAmountInUsd(v decimal.Decimal) *TransactionUpsert {
+	u.Add(transaction.FieldAmountInUsd, v)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsert) SetDescription(v string) *TransactionUpsert {
+	u.Set(transaction.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateDescription() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldDescription)
+	return u
+}
+
+// SetOriginalAmount sets the "original_amount" field.
+func (u *TransactionUpsert) SetOriginalAmount(v decimal.Decimal) *TransactionUpsert {
+	u.Set(transaction.FieldOriginalAmount, v)
+	return u
+}
+
+// UpdateOriginalAmount sets the "original_amount" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateOriginalAmount() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldOriginalAmount)
+	return u
+}
+
+// AddOriginalAmount adds v to the "original_amount" field.
+func (u *TransactionUpsert) AddOriginalAmount(v decimal.Decimal) *TransactionUpsert {
+	u.Add(transaction.FieldOriginalAmount, v)
+	return u
+}
+
+// ClearOriginalAmount clears the value of the "original_amount" field.
+func (u *TransactionUpsert) ClearOriginalAmount() *TransactionUpsert {
+	u.SetNull(transaction.FieldOriginalAmount)
+	return u
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (u *TransactionUpsert) SetOriginalCurrency(v string) *TransactionUpsert {
+	u.Set(transaction.FieldOriginalCurrency, v)
+	return u
+}
+
+// UpdateOriginalCurrency sets the "original_currency" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateOriginalCurrency() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldOriginalCurrency)
+	return u
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (u *TransactionUpsert) ClearOriginalCurrency() *TransactionUpsert {
+	u.SetNull(transaction.FieldOriginalCurrency)
+	return u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *TransactionUpsert) SetExchangeRate(v decimal.Decimal) *TransactionUpsert {
+	u.Set(transaction.FieldExchangeRate, v)
+	return u
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateExchangeRate() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldExchangeRate)
+	return u
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *TransactionUpsert) AddExchangeRate(v decimal.Decimal) *TransactionUpsert {
+	u.Add(transaction.FieldExchangeRate, v)
+	return u
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (u *TransactionUpsert) ClearExchangeRate() *TransactionUpsert {
+	u.SetNull(transaction.FieldExchangeRate)
+	return u
+}
+
+// SetExchangeRateDate sets the "exchange_rate_date" field.
+func (u *TransactionUpsert) SetExchangeRateDate(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldExchangeRateDate, v)
+	return u
+}
+
+// UpdateExchangeRateDate sets the "exchange_rate_date" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateExchangeRateDate() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldExchangeRateDate)
+	return u
+}
+
+// ClearExchangeRateDate clears the value of the "exchange_rate_date" field.
+func (u *TransactionUpsert) ClearExchangeRateDate() *TransactionUpsert {
+	u.SetNull(transaction.FieldExchangeRateDate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertOne) UpdateNewValues() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transaction.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransactionUpsertOne) Ignore() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertOne) DoNothing() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreate.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertOne) Update(set func(*TransactionUpsert)) *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *TransactionUpsertOne) SetDate(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateDate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetAmountInUsd sets the "amount_in_usd" field.
+func (u *TransactionUpsertOne) SetAmountInUsd(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmountInUsd(v)
+	})
+}
+
+// AddAmountInUsd adds v to the "amount_in_usd" field.
+func (u *TransactionUpsertOne) AddAmountInUsd(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmountInUsd(v)
+	})
+}
+
+// UpdateAmountInUsd sets the "amount_in_usd" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateAmountInUsd() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmountInUsd()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsertOne) SetDescription(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateDescription() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetOriginalAmount sets the "original_amount" field.
+func (u *TransactionUpsertOne) SetOriginalAmount(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetOriginalAmount(v)
+	})
+}
+
+// AddOriginalAmount adds v to the "original_amount" field.
+func (u *TransactionUpsertOne) AddOriginalAmount(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddOriginalAmount(v)
+	})
+}
+
+// UpdateOriginalAmount sets the "original_amount" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateOriginalAmount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateOriginalAmount()
+	})
+}
+
+// ClearOriginalAmount clears the value of the "original_amount" field.
+func (u *TransactionUpsertOne) ClearOriginalAmount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearOriginalAmount()
+	})
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (u *TransactionUpsertOne) SetOriginalCurrency(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetOriginalCurrency(v)
+	})
+}
+
+// UpdateOriginalCurrency sets the "original_currency" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateOriginalCurrency() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateOriginalCurrency()
+	})
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (u *TransactionUpsertOne) ClearOriginalCurrency() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearOriginalCurrency()
+	})
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *TransactionUpsertOne) SetExchangeRate(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetExchangeRate(v)
+	})
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *TransactionUpsertOne) AddExchangeRate(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddExchangeRate(v)
+	})
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateExchangeRate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateExchangeRate()
+	})
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (u *TransactionUpsertOne) ClearExchangeRate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearExchangeRate()
+	})
+}
+
+// SetExchangeRateDate sets the "exchange_rate_date" field.
+func (u *TransactionUpsertOne) SetExchangeRateDate(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetExchangeRateDate(v)
+	})
+}
+
+// UpdateExchangeRateDate sets the "exchange_rate_date" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateExchangeRateDate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateExchangeRateDate()
+	})
+}
+
+// ClearExchangeRateDate clears the value of the "exchange_rate_date" field.
+func (u *TransactionUpsertOne) ClearExchangeRateDate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearExchangeRateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TransactionUpsertOne.ID is not supported by MySQL driver. Use TransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
 type TransactionCreateBulk struct {
 	config
 	err      error
 	builders []*TransactionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Transaction entities in the database.
@@ -207,6 +697,7 @@ func (tcb *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, err
 					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -256,3 +747,264 @@ func (tcb *TransactionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetDate(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertBulk {
+	tcb.conflict = opts
+	return &TransactionUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TransactionCreateBulk) OnConflictColumns(columns ...string) *TransactionUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Transaction nodes.
+type TransactionUpsertBulk struct {
+	create *TransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) UpdateNewValues() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transaction.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) Ignore() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertBulk) DoNothing() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertBulk) Update(set func(*TransactionUpsert)) *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *TransactionUpsertBulk) SetDate(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateDate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetAmountInUsd sets the "amount_in_usd" field.
+func (u *TransactionUpsertBulk) SetAmountInUsd(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmountInUsd(v)
+	})
+}
+
+// AddAmountInUsd adds v to the "amount_in_usd" field.
+func (u *TransactionUpsertBulk) AddAmountInUsd(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmountInUsd(v)
+	})
+}
+
+// UpdateAmountInUsd sets the "amount_in_usd" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateAmountInUsd() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmountInUsd()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsertBulk) SetDescription(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateDescription() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetOriginalAmount sets the "original_amount" field.
+func (u *TransactionUpsertBulk) SetOriginalAmount(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetOriginalAmount(v)
+	})
+}
+
+// AddOriginalAmount adds v to the "original_amount" field.
+func (u *TransactionUpsertBulk) AddOriginalAmount(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddOriginalAmount(v)
+	})
+}
+
+// UpdateOriginalAmount sets the "original_amount" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateOriginalAmount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateOriginalAmount()
+	})
+}
+
+// ClearOriginalAmount clears the value of the "original_amount" field.
+func (u *TransactionUpsertBulk) ClearOriginalAmount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearOriginalAmount()
+	})
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (u *TransactionUpsertBulk) SetOriginalCurrency(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetOriginalCurrency(v)
+	})
+}
+
+// UpdateOriginalCurrency sets the "original_currency" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateOriginalCurrency() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateOriginalCurrency()
+	})
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (u *TransactionUpsertBulk) ClearOriginalCurrency() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearOriginalCurrency()
+	})
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *TransactionUpsertBulk) SetExchangeRate(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetExchangeRate(v)
+	})
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *TransactionUpsertBulk) AddExchangeRate(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddExchangeRate(v)
+	})
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateExchangeRate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateExchangeRate()
+	})
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (u *TransactionUpsertBulk) ClearExchangeRate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearExchangeRate()
+	})
+}
+
+// SetExchangeRateDate sets the "exchange_rate_date" field.
+func (u *TransactionUpsertBulk) SetExchangeRateDate(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetExchangeRateDate(v)
+	})
+}
+
+// UpdateExchangeRateDate sets the "exchange_rate_date" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateExchangeRateDate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateExchangeRateDate()
+	})
+}
+
+// ClearExchangeRateDate clears the value of the "exchange_rate_date" field.
+func (u *TransactionUpsertBulk) ClearExchangeRateDate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearExchangeRateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -78,6 +78,100 @@ func (tu *TransactionUpdate) SetNillableDescription(s *string) *TransactionUpdat
 	return tu
 }
 
+// SetOriginalAmount sets the "original_amount" field.
+func (tu *TransactionUpdate) SetOriginalAmount(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.ResetOriginalAmount()
+	tu.mutation.SetOriginalAmount(d)
+	return tu
+}
+
+// SetNillableOriginalAmount sets the "original_amount" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableOriginalAmount(d *decimal.Decimal) *TransactionUpdate {
+	if d != nil {
+		tu.SetOriginalAmount(*d)
+	}
+	return tu
+}
+
+// AddOriginalAmount adds d to the "original_amount" field.
+func (tu *TransactionUpdate) AddOriginalAmount(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.AddOriginalAmount(d)
+	return tu
+}
+
+// ClearOriginalAmount clears the value of the "original_amount" field.
+func (tu *TransactionUpdate) ClearOriginalAmount() *TransactionUpdate {
+	tu.mutation.ClearOriginalAmount()
+	return tu
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (tu *TransactionUpdate) SetOriginalCurrency(s string) *TransactionUpdate {
+	tu.mutation.SetOriginalCurrency(s)
+	return tu
+}
+
+// SetNillableOriginalCurrency sets the "original_currency" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableOriginalCurrency(s *string) *TransactionUpdate {
+	if s != nil {
+		tu.SetOriginalCurrency(*s)
+	}
+	return tu
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (tu *TransactionUpdate) ClearOriginalCurrency() *TransactionUpdate {
+	tu.mutation.ClearOriginalCurrency()
+	return tu
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (tu *TransactionUpdate) SetExchangeRate(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.ResetExchangeRate()
+	tu.mutation.SetExchangeRate(d)
+	return tu
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableExchangeRate(d *decimal.Decimal) *TransactionUpdate {
+	if d != nil {
+		tu.SetExchangeRate(*d)
+	}
+	return tu
+}
+
+// AddExchangeRate adds d to the "exchange_rate" field.
+func (tu *TransactionUpdate) AddExchangeRate(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.AddExchangeRate(d)
+	return tu
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (tu *TransactionUpdate) ClearExchangeRate() *TransactionUpdate {
+	tu.mutation.ClearExchangeRate()
+	return tu
+}
+
+// SetExchangeRateDate sets the "exchange_rate_date" field.
+func (tu *TransactionUpdate) SetExchangeRateDate(t time.Time) *TransactionUpdate {
+	tu.mutation.SetExchangeRateDate(t)
+	return tu
+}
+
+// SetNillableExchangeRateDate sets the "exchange_rate_date" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableExchangeRateDate(t *time.Time) *TransactionUpdate {
+	if t != nil {
+		tu.SetExchangeRateDate(*t)
+	}
+	return tu
+}
+
+// ClearExchangeRateDate clears the value of the "exchange_rate_date" field.
+func (tu *TransactionUpdate) ClearExchangeRateDate() *TransactionUpdate {
+	tu.mutation.ClearExchangeRateDate()
+	return tu
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tu *TransactionUpdate) Mutation() *TransactionMutation {
 	return tu.mutation
@@ -117,6 +211,11 @@ func (tu *TransactionUpdate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
 		}
 	}
+	if v, ok := tu.mutation.OriginalCurrency(); ok {
+		if err := transaction.OriginalCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "original_currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.original_currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -144,6 +243,36 @@ func (tu *TransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.Description(); ok {
 		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := tu.mutation.OriginalAmount(); ok {
+		_spec.SetField(transaction.FieldOriginalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedOriginalAmount(); ok {
+		_spec.AddField(transaction.FieldOriginalAmount, field.TypeFloat64, value)
+	}
+	if tu.mutation.OriginalAmountCleared() {
+		_spec.ClearField(transaction.FieldOriginalAmount, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.OriginalCurrency(); ok {
+		_spec.SetField(transaction.FieldOriginalCurrency, field.TypeString, value)
+	}
+	if tu.mutation.OriginalCurrencyCleared() {
+		_spec.ClearField(transaction.FieldOriginalCurrency, field.TypeString)
+	}
+	if value, ok := tu.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedExchangeRate(); ok {
+		_spec.AddField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if tu.mutation.ExchangeRateCleared() {
+		_spec.ClearField(transaction.FieldExchangeRate, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.ExchangeRateDate(); ok {
+		_spec.SetField(transaction.FieldExchangeRateDate, field.TypeTime, value)
+	}
+	if tu.mutation.ExchangeRateDateCleared() {
+		_spec.ClearField(transaction.FieldExchangeRateDate, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transaction.Label}
@@ -213,6 +342,100 @@ func (tuo *TransactionUpdateOne) SetNillableDescription(s *string) *TransactionU
 	return tuo
 }
 
+// SetOriginalAmount sets the "original_amount" field.
+func (tuo *TransactionUpdateOne) SetOriginalAmount(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.ResetOriginalAmount()
+	tuo.mutation.SetOriginalAmount(d)
+	return tuo
+}
+
+// SetNillableOriginalAmount sets the "original_amount" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableOriginalAmount(d *decimal.Decimal) *TransactionUpdateOne {
+	if d != nil {
+		tuo.SetOriginalAmount(*d)
+	}
+	return tuo
+}
+
+// AddOriginalAmount adds d to the "original_amount" field.
+func (tuo *TransactionUpdateOne) AddOriginalAmount(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.AddOriginalAmount(d)
+	return tuo
+}
+
+// ClearOriginalAmount clears the value of the "original_amount" field.
+func (tuo *TransactionUpdateOne) ClearOriginalAmount() *TransactionUpdateOne {
+	tuo.mutation.ClearOriginalAmount()
+	return tuo
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (tuo *TransactionUpdateOne) SetOriginalCurrency(s string) *TransactionUpdateOne {
+	tuo.mutation.SetOriginalCurrency(s)
+	return tuo
+}
+
+// SetNillableOriginalCurrency sets the "original_currency" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableOriginalCurrency(s *string) *TransactionUpdateOne {
+	if s != nil {
+		tuo.SetOriginalCurrency(*s)
+	}
+	return tuo
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (tuo *TransactionUpdateOne) ClearOriginalCurrency() *TransactionUpdateOne {
+	tuo.mutation.ClearOriginalCurrency()
+	return tuo
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (tuo *TransactionUpdateOne) SetExchangeRate(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.ResetExchangeRate()
+	tuo.mutation.SetExchangeRate(d)
+	return tuo
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableExchangeRate(d *decimal.Decimal) *TransactionUpdateOne {
+	if d != nil {
+		tuo.SetExchangeRate(*d)
+	}
+	return tuo
+}
+
+// AddExchangeRate adds d to the "exchange_rate" field.
+func (tuo *TransactionUpdateOne) AddExchangeRate(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.AddExchangeRate(d)
+	return tuo
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (tuo *TransactionUpdateOne) ClearExchangeRate() *TransactionUpdateOne {
+	tuo.mutation.ClearExchangeRate()
+	return tuo
+}
+
+// SetExchangeRateDate sets the "exchange_rate_date" field.
+func (tuo *TransactionUpdateOne) SetExchangeRateDate(t time.Time) *TransactionUpdateOne {
+	tuo.mutation.SetExchangeRateDate(t)
+	return tuo
+}
+
+// SetNillableExchangeRateDate sets the "exchange_rate_date" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableExchangeRateDate(t *time.Time) *TransactionUpdateOne {
+	if t != nil {
+		tuo.SetExchangeRateDate(*t)
+	}
+	return tuo
+}
+
+// ClearExchangeRateDate clears the value of the "exchange_rate_date" field.
+func (tuo *TransactionUpdateOne) ClearExchangeRateDate() *TransactionUpdateOne {
+	tuo.mutation.ClearExchangeRateDate()
+	return tuo
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tuo *TransactionUpdateOne) Mutation() *TransactionMutation {
 	return tuo.mutation
@@ -265,6 +488,11 @@ func (tuo *TransactionUpdateOne) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.OriginalCurrency(); ok {
+		if err := transaction.OriginalCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "original_currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.original_currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -309,6 +537,36 @@ func (tuo *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transactio
 	if value, ok := tuo.mutation.Description(); ok {
 		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := tuo.mutation.OriginalAmount(); ok {
+		_spec.SetField(transaction.FieldOriginalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedOriginalAmount(); ok {
+		_spec.AddField(transaction.FieldOriginalAmount, field.TypeFloat64, value)
+	}
+	if tuo.mutation.OriginalAmountCleared() {
+		_spec.ClearField(transaction.FieldOriginalAmount, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.OriginalCurrency(); ok {
+		_spec.SetField(transaction.FieldOriginalCurrency, field.TypeString, value)
+	}
+	if tuo.mutation.OriginalCurrencyCleared() {
+		_spec.ClearField(transaction.FieldOriginalCurrency, field.TypeString)
+	}
+	if value, ok := tuo.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedExchangeRate(); ok {
+		_spec.AddField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if tuo.mutation.ExchangeRateCleared() {
+		_spec.ClearField(transaction.FieldExchangeRate, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.ExchangeRateDate(); ok {
+		_spec.SetField(transaction.FieldExchangeRateDate, field.TypeTime, value)
+	}
+	if tuo.mutation.ExchangeRateDateCleared() {
+		_spec.ClearField(transaction.FieldExchangeRateDate, field.TypeTime)
+	}
 	_node = &Transaction{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
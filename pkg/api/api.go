@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"time"
@@ -8,8 +9,10 @@ import (
 	"github.com/eddie023/wex-tag/ent"
 	"github.com/eddie023/wex-tag/pkg/apiout"
 	"github.com/eddie023/wex-tag/pkg/config"
+	"github.com/eddie023/wex-tag/pkg/health"
 	"github.com/eddie023/wex-tag/pkg/types"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/cors"
@@ -25,14 +28,21 @@ type API struct {
 	Logger              *slog.Logger
 	TransactionService  TransactionService
 	ExchangeRateService ExchangeRateService
+	IdempotencyService  IdempotencyService
+	Health              *health.Checker
 }
 
 func (a *API) Handler() http.Handler {
 	router := chi.NewRouter()
 	router.Use(middleware.RealIP)
+	router.Use(traceRequests(a.Swagger))
+	router.Use(requestMetrics(a.Swagger))
 	router.Use(middleware.AllowContentType("application/json"))
 	router.Use(httplog.RequestLogger(getChiSlogLogger(a.Logger)))
 	router.Use(cors.Default().Handler)
+	router.Use(apiout.ProblemInstance)
+	router.NotFound(apiout.NotFound)
+	router.MethodNotAllowed(apiout.MethodNotAllowed)
 
 	// dummy healthcheck handler
 	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
@@ -47,8 +57,15 @@ func (a *API) Handler() http.Handler {
 		apiout.JSON(ctx, w, out, http.StatusOK)
 	})
 
+	a.routeHealth(router)
+
+	if a.Config.API.ServeDocs {
+		routeDocs(router)
+	}
+
 	router.Group(func(r chi.Router) {
-		r.Use(httpMiddleware.OapiRequestValidator(a.Swagger))
+		r.Use(requestDeadline(a.Config.API.RequestTimeout))
+		r.Use(a.requestValidator())
 		types.HandlerWithOptions(a, types.ChiServerOptions{
 			BaseRouter: r,
 		})
@@ -57,6 +74,36 @@ func (a *API) Handler() http.Handler {
 	return router
 }
 
+// requestDeadline is a middleware which cancels the context of the request after the timeout, such that calls made
+// on behalf of the request give up before the server stops waiting for the response. Unlike middleware.Timeout, the
+// handler still writes its own response.
+func requestDeadline(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if timeout <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// requestValidator will validate every request against the OpenAPI spec and report all of the violations as problem details.
+func (a *API) requestValidator() func(http.Handler) http.Handler {
+	return withValidationErrorContext(httpMiddleware.OapiRequestValidatorWithOptions(a.Swagger, &httpMiddleware.Options{
+		Options: openapi3filter.Options{
+			MultiError: true,
+		},
+		ErrorHandler:      writeValidationError,
+		MultiErrorHandler: handleValidationErrors,
+	}))
+}
+
 // getChiSlogLogger will initiate a structured logging for chi logger middleware.
 func getChiSlogLogger(s *slog.Logger) *httplog.Logger {
 	return &httplog.Logger{
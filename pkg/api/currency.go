@@ -0,0 +1,21 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/types"
+)
+
+// GET /currencies?country=""
+func (a *API) ListCurrencies(w http.ResponseWriter, r *http.Request, params types.ListCurrenciesParams) {
+	ctx := r.Context()
+
+	currencies, err := a.ExchangeRateService.ListCurrencies(ctx, stringValue(params.Country))
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	apiout.JSON(ctx, w, types.ListCurrencies{Items: currencies}, http.StatusOK)
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eddie023/wex-tag/pkg/api/mocks"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/assert"
+)
+
+func TestListCurrenciesAPI(t *testing.T) {
+	countryCode, currencyCode := "NP", "NPR"
+
+	type testcase struct {
+		name       string
+		queryParam string
+
+		wantPrefix     string
+		mockCurrencies []types.SupportedCurrency
+		mockErr        error
+
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name: "should list every currency",
+			mockCurrencies: []types.SupportedCurrency{
+				{Country: "Nepal", Currency: "Rupee", CountryCurrencyDesc: "Nepal-Rupee", CountryCode: &countryCode, CurrencyCode: &currencyCode, FirstRecordDate: "2022-06-30", LastRecordDate: "2022-12-31", LatestRate: "132.5", Provider: "treasury"},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"items":[{"country":"Nepal","countryCode":"NP","countryCurrencyDesc":"Nepal-Rupee","currency":"Rupee","currencyCode":"NPR","firstRecordDate":"2022-06-30","lastRecordDate":"2022-12-31","latestRate":"132.5","provider":"treasury"}]}`,
+		},
+		{
+			name:           "should pass the country prefix",
+			queryParam:     "country=uni",
+			wantPrefix:     "uni",
+			mockCurrencies: []types.SupportedCurrency{},
+			wantCode:       http.StatusOK,
+			wantBody:       `{"items":[]}`,
+		},
+		{
+			name:     "should fail when the currencies cannot be listed",
+			mockErr:  apiout.NewCodedError(apiout.CodeExchangeRateServiceUnavailable, errors.New("the treasury service is unavailable")),
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: `"code":"exchange_rate_service_unavailable"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockExchangeRateService(ctrl)
+			m.EXPECT().ListCurrencies(gomock.Any(), tc.wantPrefix).Return(tc.mockCurrencies, tc.mockErr)
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			swagger.Servers = nil
+
+			a := API{ExchangeRateService: m, Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", "/currencies?"+tc.queryParam, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Assert(t, strings.Contains(string(data), tc.wantBody), "got body = %s", data)
+		})
+	}
+}
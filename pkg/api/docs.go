@@ -0,0 +1,103 @@
+package api
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"log/slog"
+	"net/http"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/invopop/yaml"
+)
+
+// docsAssets holds the API docs page, which is Swagger UI 5.18.2 from the swagger-ui-dist package configured by
+// swagger-initializer.js to render '/openapi.json'. The assets are self hosted, thus the page works without access to any
+// CDN. To update Swagger UI, replace swagger-ui-bundle.js, swagger-ui-standalone-preset.js, swagger-ui.css and index.css
+// with the ones of the newer swagger-ui-dist release.
+//
+//go:embed docs
+var docsAssets embed.FS
+
+// routeDocs will serve the embedded OpenAPI spec as '/openapi.json' and '/openapi.yaml' and the API docs under '/docs'.
+func routeDocs(r chi.Router) {
+	assets, err := fs.Sub(docsAssets, "docs")
+	if err != nil {
+		panic(err)
+	}
+
+	r.Get("/openapi.json", getOpenAPISpecJSON)
+	r.Get("/openapi.yaml", getOpenAPISpecYAML)
+	r.Get("/docs", http.RedirectHandler("/docs/", http.StatusMovedPermanently).ServeHTTP)
+	r.Handle("/docs/*", http.StripPrefix("/docs/", http.FileServer(http.FS(assets))))
+}
+
+func getOpenAPISpecJSON(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := getOpenAPISpec(r)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	writeSpec(w, "application/json", data)
+}
+
+func getOpenAPISpecYAML(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := getOpenAPISpec(r)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	data, err = yaml.JSONToYAML(data)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	writeSpec(w, "application/yaml", data)
+}
+
+// getOpenAPISpec will return the embedded spec as JSON with the servers pointing to the host the request was made to.
+// The spec is loaded on every call as the one used for request validation has no servers.
+func getOpenAPISpec(r *http.Request) ([]byte, error) {
+	swagger, err := types.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	swagger.Servers = openapi3.Servers{
+		{URL: getRequestScheme(r) + "://" + r.Host},
+	}
+
+	return json.Marshal(swagger)
+}
+
+// getRequestScheme will prefer the scheme reported by a proxy in front of the API.
+func getRequestScheme(r *http.Request) string {
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		return proto
+	}
+
+	if r.TLS != nil {
+		return "https"
+	}
+
+	return "http"
+}
+
+func writeSpec(w http.ResponseWriter, contentType string, data []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(data); err != nil {
+		slog.Error("writing response", "err", err)
+	}
+}
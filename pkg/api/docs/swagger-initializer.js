@@ -0,0 +1,12 @@
+window.onload = function () {
+  // the spec is served by the API itself with the servers pointing to the host the docs were loaded from, thus
+  // "Try it out" calls the same API
+  window.ui = SwaggerUIBundle({
+    url: "../openapi.json",
+    dom_id: "#swagger-ui",
+    deepLinking: true,
+    presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+    plugins: [SwaggerUIBundle.plugins.DownloadUrl],
+    layout: "StandaloneLayout",
+  });
+};
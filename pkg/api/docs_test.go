@@ -0,0 +1,120 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"gotest.tools/assert"
+)
+
+func TestDocsAPI(t *testing.T) {
+	type testcase struct {
+		name       string
+		path       string
+		giveHeader http.Header
+
+		wantCode        int
+		wantContentType string
+		wantBody        string
+	}
+
+	testcases := []testcase{
+		{
+			name:            "should serve the spec as json with the request host as server",
+			path:            "/openapi.json",
+			wantCode:        http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `"servers":[{"url":"http://api.example.com"}]`,
+		},
+		{
+			name:            "should use the scheme reported by the proxy",
+			path:            "/openapi.json",
+			giveHeader:      http.Header{"X-Forwarded-Proto": []string{"https"}},
+			wantCode:        http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `"servers":[{"url":"https://api.example.com"}]`,
+		},
+		{
+			name:            "should serve the spec as yaml",
+			path:            "/openapi.yaml",
+			wantCode:        http.StatusOK,
+			wantContentType: "application/yaml",
+			wantBody:        "- url: http://api.example.com",
+		},
+		{
+			name:     "should redirect to the docs page",
+			path:     "/docs",
+			wantCode: http.StatusMovedPermanently,
+		},
+		{
+			name:            "should serve the docs page",
+			path:            "/docs/",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/html; charset=utf-8",
+			wantBody:        `<div id="swagger-ui"></div>`,
+		},
+		{
+			name:            "should point swagger ui to the served spec",
+			path:            "/docs/swagger-initializer.js",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/javascript; charset=utf-8",
+			wantBody:        `url: "../openapi.json"`,
+		},
+		{
+			name:            "should serve the swagger ui bundle",
+			path:            "/docs/swagger-ui-bundle.js",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/javascript; charset=utf-8",
+			wantBody:        `SwaggerUIBundle`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			routeDocs(r)
+
+			req := httptest.NewRequest("GET", "http://api.example.com"+tc.path, nil)
+			for k := range tc.giveHeader {
+				req.Header.Set(k, tc.giveHeader.Get(k))
+			}
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantContentType != "" {
+				assert.Equal(t, tc.wantContentType, rr.Header().Get("Content-Type"))
+			}
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestServedSpecIsValid(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://api.example.com/openapi.json", nil)
+	rr := httptest.NewRecorder()
+
+	getOpenAPISpecJSON(rr, req)
+
+	spec, err := openapi3.NewLoader().LoadFromData(rr.Body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NilError(t, spec.Validate(req.Context()))
+	assert.Assert(t, spec.Paths.Find("/purchase/{transactionId}") != nil)
+}
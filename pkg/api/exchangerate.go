@@ -0,0 +1,21 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/types"
+)
+
+// GET /exchange-rates?country=""&currency=""&from=""&to=""&purchaseDate=""
+func (a *API) ListExchangeRates(w http.ResponseWriter, r *http.Request, params types.ListExchangeRatesParams) {
+	ctx := r.Context()
+
+	history, err := a.ExchangeRateService.GetExchangeRateHistory(ctx, params)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	apiout.JSON(ctx, w, history, http.StatusOK)
+}
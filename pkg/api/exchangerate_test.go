@@ -0,0 +1,102 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eddie023/wex-tag/pkg/api/mocks"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/assert"
+)
+
+func TestListExchangeRatesAPI(t *testing.T) {
+	provider, eligible := "treasury", true
+
+	type testcase struct {
+		name       string
+		queryParam string
+
+		mockHistory *types.ExchangeRateHistory
+
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:       "should return the exchange rate history",
+			queryParam: "country=Nepal&currency=Rupee&from=2022-01-01&to=2022-12-31&purchaseDate=2022-12-30",
+			mockHistory: &types.ExchangeRateHistory{
+				Country:             "Nepal",
+				Currency:            "Rupee",
+				CountryCurrencyDesc: "Nepal-Rupee",
+				From:                "2022-01-01",
+				To:                  "2022-12-31",
+				Provider:            &provider,
+				Rates:               []types.ExchangeRatePoint{{RecordDate: "2022-09-30", ExchangeRate: "130.5", Eligible: &eligible}},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `"rates":[{"eligible":true,"exchangeRate":"130.5","recordDate":"2022-09-30"}]`,
+		},
+		{
+			name:       "should fail if currency query param is not passed",
+			queryParam: "country=Nepal",
+			wantCode:   http.StatusBadRequest,
+			wantBody:   `"errors":[{"in":"query","name":"currency","reason":"value is required but missing"}]`,
+		},
+		{
+			name:       "should fail for invalid date",
+			queryParam: "country=Nepal&currency=Rupee&from=yesterday",
+			wantCode:   http.StatusBadRequest,
+			wantBody:   `"name":"from"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockExchangeRateService(ctrl)
+			if tc.mockHistory != nil {
+				m.EXPECT().GetExchangeRateHistory(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, params types.ListExchangeRatesParams) (types.ExchangeRateHistory, error) {
+					assert.Equal(t, "Nepal", params.Country)
+					assert.Equal(t, "2022-12-30", params.PurchaseDate.String())
+
+					return *tc.mockHistory, nil
+				})
+			}
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			swagger.Servers = nil
+
+			a := API{ExchangeRateService: m, Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", "/exchange-rates?"+tc.queryParam, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Assert(t, strings.Contains(string(data), tc.wantBody), "got body = %s", data)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/health"
+	"github.com/go-chi/chi/v5"
+)
+
+// routeHealth will serve '/healthz' which reports the process is alive and '/readyz' which reports whether
+// all of the dependencies are available.
+func (a *API) routeHealth(r chi.Router) {
+	r.Get("/healthz", a.healthz)
+	r.Get("/readyz", a.readyz)
+}
+
+func (a *API) healthz(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	out := struct {
+		Status string `json:"status"`
+	}{
+		Status: health.StatusOK,
+	}
+
+	apiout.JSON(ctx, w, out, http.StatusOK)
+}
+
+func (a *API) readyz(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// an API built without a checker has no dependencies to check
+	if a.Health == nil {
+		apiout.JSON(ctx, w, health.Report{Status: health.StatusReady, Checks: map[string]health.CheckResult{}}, http.StatusOK)
+		return
+	}
+
+	report := a.Health.Run(ctx)
+
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+
+	apiout.JSON(ctx, w, report, status)
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eddie023/wex-tag/pkg/health"
+	"github.com/go-chi/chi/v5"
+	"gotest.tools/assert"
+)
+
+func TestHealthAPI(t *testing.T) {
+	type testcase struct {
+		name          string
+		path          string
+		giveErr       error
+		giveShutdown  bool
+		giveNoChecker bool
+
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "should report the process is alive",
+			path:     "/healthz",
+			giveErr:  errors.New("connection refused"),
+			wantCode: http.StatusOK,
+			wantBody: `{"status":"ok"}`,
+		},
+		{
+			name:     "should report ready with every check",
+			path:     "/readyz",
+			wantCode: http.StatusOK,
+			wantBody: `"status":"ready","checks":{"database":{"status":"ok","latencyMs":`,
+		},
+		{
+			name:     "should report not ready when a dependency is unavailable",
+			path:     "/readyz",
+			giveErr:  errors.New("connection refused"),
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: `"error":"connection refused"`,
+		},
+		{
+			name:         "should report not ready during shutdown",
+			path:         "/readyz",
+			giveShutdown: true,
+			wantCode:     http.StatusServiceUnavailable,
+			wantBody:     `{"status":"shutting_down","checks":{}}`,
+		},
+		{
+			name:          "should report ready without a checker",
+			path:          "/readyz",
+			giveNoChecker: true,
+			wantCode:      http.StatusOK,
+			wantBody:      `{"status":"ready","checks":{}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := &health.Checker{
+				Checks: []health.Check{
+					{Name: "database", Check: func(ctx context.Context) error { return tc.giveErr }},
+				},
+			}
+			if tc.giveShutdown {
+				checker.Shutdown()
+			}
+
+			a := API{Health: checker}
+			if tc.giveNoChecker {
+				a.Health = nil
+			}
+			r := chi.NewRouter()
+			a.routeHealth(r)
+
+			req := httptest.NewRequest("GET", tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
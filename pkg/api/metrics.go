@@ -0,0 +1,74 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// unmatchedOperation labels the requests which did not match any route.
+const unmatchedOperation = "unmatched"
+
+// requestMetrics is a middleware which records the request count and latency by the OpenAPI operation id. Routes which
+// are not part of the spec, such as the health checks, are labelled by their route pattern.
+func requestMetrics(swagger *openapi3.T) func(http.Handler) http.Handler {
+	operations := getOperationIds(swagger)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			operation := getOperation(r, operations)
+
+			// handlers which never write the header implicitly respond with 200
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			metrics.HTTPRequests.WithLabelValues(operation, strconv.Itoa(status)).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+// getOperation will return the operation id of the route the request matched. It must be called after the request
+// was routed, thus after calling the next handler in a middleware.
+func getOperation(r *http.Request, operations map[string]string) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.RoutePattern() == "" {
+		return unmatchedOperation
+	}
+
+	if id, ok := operations[r.Method+" "+rctx.RoutePattern()]; ok {
+		return id
+	}
+
+	return rctx.RoutePattern()
+}
+
+// getOperationIds will map the method and path of every operation in the spec to its operation id. Note the embedded
+// spec carries the operation ids as named by the generated handlers, e.g. GetPurchaseTransaction.
+func getOperationIds(swagger *openapi3.T) map[string]string {
+	operations := make(map[string]string)
+	if swagger == nil || swagger.Paths == nil {
+		return operations
+	}
+
+	for path, item := range swagger.Paths {
+		for method, op := range item.Operations() {
+			operations[strings.ToUpper(method)+" "+path] = op.OperationID
+		}
+	}
+
+	return operations
+}
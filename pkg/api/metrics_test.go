@@ -0,0 +1,82 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/assert"
+)
+
+func TestRequestMetrics(t *testing.T) {
+	type testcase struct {
+		name   string
+		method string
+		path   string
+
+		wantOperation string
+		wantCode      string
+	}
+
+	testcases := []testcase{
+		{
+			name:          "should label spec routes by operation id",
+			method:        "GET",
+			path:          "/purchase/680ed945-c2c3-4534-84e8-4ba6ed69eeea",
+			wantOperation: "GetPurchaseTransaction",
+			wantCode:      "200",
+		},
+		{
+			name:          "should label other routes by route pattern",
+			method:        "GET",
+			path:          "/healthz",
+			wantOperation: "/healthz",
+			wantCode:      "200",
+		},
+		{
+			name:          "should label unknown routes as unmatched",
+			method:        "GET",
+			path:          "/unknown",
+			wantOperation: unmatchedOperation,
+			wantCode:      "404",
+		},
+	}
+
+	swagger, err := types.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			r.Use(requestMetrics(swagger))
+			r.Get("/purchase/{transactionId}", func(w http.ResponseWriter, r *http.Request) {})
+			r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {})
+
+			before := testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues(tc.wantOperation, tc.wantCode))
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			after := testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues(tc.wantOperation, tc.wantCode))
+			assert.Equal(t, before+1, after)
+		})
+	}
+}
+
+func TestGetOperationIds(t *testing.T) {
+	swagger, err := types.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operations := getOperationIds(swagger)
+
+	assert.Equal(t, "GetPurchaseTransaction", operations["GET /purchase/{transactionId}"])
+	assert.Equal(t, "ConvertPurchaseTransactions", operations["POST /purchase/convert"])
+}
@@ -42,18 +42,46 @@ func (m *MockExchangeRateService) EXPECT() *MockExchangeRateServiceMockRecorder
 }
 
 // ConvertCurrency mocks base method.
-func (m *MockExchangeRateService) ConvertCurrency(arg0 service.ExchangeRatePayload, arg1 *ent.Transaction, arg2 service.ExchangeRateResponse) (types.GetPurchaseTransaction, error) {
+func (m *MockExchangeRateService) ConvertCurrency(arg0 context.Context, arg1 service.ExchangeRatePayload, arg2 *ent.Transaction, arg3 service.ExchangeRateResponse) (types.GetPurchaseTransaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConvertCurrency", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ConvertCurrency", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(types.GetPurchaseTransaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConvertCurrency indicates an expected call of ConvertCurrency.
-func (mr *MockExchangeRateServiceMockRecorder) ConvertCurrency(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockExchangeRateServiceMockRecorder) ConvertCurrency(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertCurrency", reflect.TypeOf((*MockExchangeRateService)(nil).ConvertCurrency), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertCurrency", reflect.TypeOf((*MockExchangeRateService)(nil).ConvertCurrency), arg0, arg1, arg2, arg3)
+}
+
+// ConvertToCurrencies mocks base method.
+func (m *MockExchangeRateService) ConvertToCurrencies(arg0 context.Context, arg1 *ent.Transaction, arg2 []types.ConversionTarget, arg3 service.RateSelectionPolicy) []types.CurrencyConversionResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertToCurrencies", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]types.CurrencyConversionResult)
+	return ret0
+}
+
+// ConvertToCurrencies indicates an expected call of ConvertToCurrencies.
+func (mr *MockExchangeRateServiceMockRecorder) ConvertToCurrencies(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertToCurrencies", reflect.TypeOf((*MockExchangeRateService)(nil).ConvertToCurrencies), arg0, arg1, arg2, arg3)
+}
+
+// ConvertTransactions mocks base method.
+func (m *MockExchangeRateService) ConvertTransactions(arg0 context.Context, arg1 []*ent.Transaction, arg2 types.ConversionTarget, arg3 service.RateSelectionPolicy) []types.CurrencyConversionResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertTransactions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]types.CurrencyConversionResult)
+	return ret0
+}
+
+// ConvertTransactions indicates an expected call of ConvertTransactions.
+func (mr *MockExchangeRateServiceMockRecorder) ConvertTransactions(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertTransactions", reflect.TypeOf((*MockExchangeRateService)(nil).ConvertTransactions), arg0, arg1, arg2, arg3)
 }
 
 // GetExchangeRate mocks base method.
@@ -70,3 +98,33 @@ func (mr *MockExchangeRateServiceMockRecorder) GetExchangeRate(arg0, arg1 any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockExchangeRateService)(nil).GetExchangeRate), arg0, arg1)
 }
+
+// GetExchangeRateHistory mocks base method.
+func (m *MockExchangeRateService) GetExchangeRateHistory(arg0 context.Context, arg1 types.ListExchangeRatesParams) (types.ExchangeRateHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRateHistory", arg0, arg1)
+	ret0, _ := ret[0].(types.ExchangeRateHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRateHistory indicates an expected call of GetExchangeRateHistory.
+func (mr *MockExchangeRateServiceMockRecorder) GetExchangeRateHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRateHistory", reflect.TypeOf((*MockExchangeRateService)(nil).GetExchangeRateHistory), arg0, arg1)
+}
+
+// ListCurrencies mocks base method.
+func (m *MockExchangeRateService) ListCurrencies(arg0 context.Context, arg1 string) ([]types.SupportedCurrency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrencies", arg0, arg1)
+	ret0, _ := ret[0].([]types.SupportedCurrency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrencies indicates an expected call of ListCurrencies.
+func (mr *MockExchangeRateServiceMockRecorder) ListCurrencies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrencies", reflect.TypeOf((*MockExchangeRateService)(nil).ListCurrencies), arg0, arg1)
+}
@@ -70,3 +70,34 @@ func (mr *MockTransactionServiceMockRecorder) GetPurchaseDetailsByTransactionId(
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseDetailsByTransactionId", reflect.TypeOf((*MockTransactionService)(nil).GetPurchaseDetailsByTransactionId), arg0, arg1)
 }
+
+// GetPurchaseDetailsByTransactionIds mocks base method.
+func (m *MockTransactionService) GetPurchaseDetailsByTransactionIds(arg0 context.Context, arg1 []uuid.UUID) ([]*ent.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchaseDetailsByTransactionIds", arg0, arg1)
+	ret0, _ := ret[0].([]*ent.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchaseDetailsByTransactionIds indicates an expected call of GetPurchaseDetailsByTransactionIds.
+func (mr *MockTransactionServiceMockRecorder) GetPurchaseDetailsByTransactionIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseDetailsByTransactionIds", reflect.TypeOf((*MockTransactionService)(nil).GetPurchaseDetailsByTransactionIds), arg0, arg1)
+}
+
+// ListPurchaseTransactions mocks base method.
+func (m *MockTransactionService) ListPurchaseTransactions(arg0 context.Context, arg1 types.ListPurchaseTransactionsParams) ([]*ent.Transaction, *string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPurchaseTransactions", arg0, arg1)
+	ret0, _ := ret[0].([]*ent.Transaction)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPurchaseTransactions indicates an expected call of ListPurchaseTransactions.
+func (mr *MockTransactionServiceMockRecorder) ListPurchaseTransactions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPurchaseTransactions", reflect.TypeOf((*MockTransactionService)(nil).ListPurchaseTransactions), arg0, arg1)
+}
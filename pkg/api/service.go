@@ -13,10 +13,23 @@ import (
 type TransactionService interface {
 	CreateNewPurchaseTransaction(ctx context.Context, payload types.CreateNewPurchaseTransaction) (types.Transaction, error)
 	GetPurchaseDetailsByTransactionId(ctx context.Context, transactionId uuid.UUID) (*ent.Transaction, error)
+	GetPurchaseDetailsByTransactionIds(ctx context.Context, transactionIds []uuid.UUID) ([]*ent.Transaction, error)
+	ListPurchaseTransactions(ctx context.Context, params types.ListPurchaseTransactionsParams) ([]*ent.Transaction, *string, error)
 }
 
 //go:generate go run go.uber.org/mock/mockgen -destination=mocks/mock_exchange_rate.go -package=mocks . ExchangeRateService
 type ExchangeRateService interface {
 	GetExchangeRate(ctx context.Context, payload service.ExchangeRatePayload) (service.ExchangeRateResponse, error)
-	ConvertCurrency(requestConversionPayload service.ExchangeRatePayload, transactionInfo *ent.Transaction, exchangeRateInfo service.ExchangeRateResponse) (types.GetPurchaseTransaction, error)
+	ConvertCurrency(ctx context.Context, requestConversionPayload service.ExchangeRatePayload, transactionInfo *ent.Transaction, exchangeRateInfo service.ExchangeRateResponse) (types.GetPurchaseTransaction, error)
+	ConvertToCurrencies(ctx context.Context, transactionInfo *ent.Transaction, targets []types.ConversionTarget, policy service.RateSelectionPolicy) []types.CurrencyConversionResult
+	ConvertTransactions(ctx context.Context, transactions []*ent.Transaction, target types.ConversionTarget, policy service.RateSelectionPolicy) []types.CurrencyConversionResult
+	ListCurrencies(ctx context.Context, countryPrefix string) ([]types.SupportedCurrency, error)
+	GetExchangeRateHistory(ctx context.Context, params types.ListExchangeRatesParams) (types.ExchangeRateHistory, error)
+}
+
+//go:generate go run go.uber.org/mock/mockgen -destination=mocks/mock_idempotency.go -package=mocks . IdempotencyService
+type IdempotencyService interface {
+	Reserve(ctx context.Context, key string, requestHash string) (service.IdempotencyReservation, *service.IdempotentResponse, error)
+	Complete(ctx context.Context, reservation service.IdempotencyReservation, response service.IdempotentResponse) error
+	Release(ctx context.Context, reservation service.IdempotencyReservation) error
 }
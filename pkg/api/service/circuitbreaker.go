@@ -0,0 +1,155 @@
+package service
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/metrics"
+)
+
+// default thresholds of the circuit breaker
+const (
+	DefaultCircuitFailureThreshold = 5
+	DefaultCircuitOpenTimeout      = 30 * time.Second
+)
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every call through.
+	CircuitClosed CircuitState = iota
+	// CircuitHalfOpen lets a single probe call through to find out whether the service recovered.
+	CircuitHalfOpen
+	// CircuitOpen fails every call without calling the service.
+	CircuitOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half_open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops calling a failing service after FailureThreshold consecutive failures. Once OpenTimeout has
+// passed a single probe call is let through, which closes the circuit on success or opens it again on failure.
+type CircuitBreaker struct {
+	// Name labels the metrics and logs of the circuit breaker
+	Name             string
+	FailureThreshold int
+	OpenTimeout      time.Duration
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// Allow will return an error without calling the service while the circuit is open. Every allowed call must be
+// followed by one of Success, Failure or Release.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		retryIn := b.getOpenTimeout() - time.Since(b.openedAt)
+		if retryIn > 0 {
+			return apiout.NewCodedError(apiout.CodeExchangeRateServiceUnavailable, fmt.Errorf("the %s service is unavailable, retry in %s", b.Name, retryIn.Round(time.Second)))
+		}
+
+		b.setState(CircuitHalfOpen)
+		b.probing = true
+
+		return nil
+	case CircuitHalfOpen:
+		if b.probing {
+			return apiout.NewCodedError(apiout.CodeExchangeRateServiceUnavailable, fmt.Errorf("the %s service is unavailable, waiting for it to recover", b.Name))
+		}
+
+		b.probing = true
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Success records a successful call, which closes the circuit.
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+
+	if b.state != CircuitClosed {
+		b.setState(CircuitClosed)
+	}
+}
+
+// Failure records a failed call, which opens the circuit when the threshold is reached or the probe call failed.
+func (b *CircuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+
+	if b.state == CircuitHalfOpen || (b.state == CircuitClosed && b.failures >= b.getFailureThreshold()) {
+		b.openedAt = time.Now()
+		b.setState(CircuitOpen)
+	}
+}
+
+// Release records a call which neither failed nor succeeded, e.g. cancelled by the caller, such that another probe call is let through.
+func (b *CircuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// State returns the current state of the circuit.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+func (b *CircuitBreaker) setState(state CircuitState) {
+	slog.Warn("circuit breaker state changed", "name", b.Name, "from", b.state.String(), "to", state.String(), "failures", b.failures)
+
+	b.state = state
+
+	metrics.CircuitBreakerState.WithLabelValues(b.Name).Set(float64(state))
+	metrics.CircuitBreakerTransitions.WithLabelValues(b.Name, state.String()).Inc()
+}
+
+func (b *CircuitBreaker) getFailureThreshold() int {
+	if b.FailureThreshold <= 0 {
+		return DefaultCircuitFailureThreshold
+	}
+
+	return b.FailureThreshold
+}
+
+func (b *CircuitBreaker) getOpenTimeout() time.Duration {
+	if b.OpenTimeout <= 0 {
+		return DefaultCircuitOpenTimeout
+	}
+
+	return b.OpenTimeout
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"gotest.tools/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	b := &CircuitBreaker{Name: "test", FailureThreshold: 2, OpenTimeout: 20 * time.Millisecond}
+
+	// failures below the threshold keep the circuit closed
+	assert.NilError(t, b.Allow())
+	b.Failure()
+	assert.Equal(t, CircuitClosed, b.State())
+
+	// success resets the consecutive failures
+	assert.NilError(t, b.Allow())
+	b.Success()
+	assert.NilError(t, b.Allow())
+	b.Failure()
+	assert.Equal(t, CircuitClosed, b.State())
+
+	assert.NilError(t, b.Allow())
+	b.Failure()
+	assert.Equal(t, CircuitOpen, b.State())
+
+	err := b.Allow()
+	assert.Assert(t, err != nil)
+
+	aerr, ok := err.(*apiout.APIError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusServiceUnavailable, aerr.GetHttpStatus())
+
+	time.Sleep(30 * time.Millisecond)
+
+	// a single probe is let through once the open timeout passed
+	assert.NilError(t, b.Allow())
+	assert.Equal(t, CircuitHalfOpen, b.State())
+	assert.Assert(t, b.Allow() != nil)
+
+	// failed probe opens the circuit again
+	b.Failure()
+	assert.Equal(t, CircuitOpen, b.State())
+
+	time.Sleep(30 * time.Millisecond)
+
+	// cancelled probe lets another probe through
+	assert.NilError(t, b.Allow())
+	b.Release()
+	assert.NilError(t, b.Allow())
+
+	b.Success()
+	assert.Equal(t, CircuitClosed, b.State())
+}
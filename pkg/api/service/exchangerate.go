@@ -8,165 +8,553 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/eddie023/wex-tag/ent"
 	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/config"
+	"github.com/eddie023/wex-tag/pkg/currency"
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"github.com/eddie023/wex-tag/pkg/money"
 	"github.com/eddie023/wex-tag/pkg/types"
 	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-type ExchangeRateGetter struct{}
+// ExchangeRateGetter calls the Treasury rates of exchange API. The zero value calls the public API with the default
+// timeout and retry policy.
+type ExchangeRateGetter struct {
+	// BaseURL of the rates of exchange API, defaults to TREASURY_RATES_OF_EXCHANGE_API_URL
+	BaseURL string
+	// Client makes the HTTP calls, defaults to a client which traces every call
+	Client HttpRequestDoer
+	// Timeout of a single call to the API, defaults to DefaultTreasuryTimeout
+	Timeout time.Duration
+	Retry   RetryPolicy
+	// Breaker fails the calls fast while the API keeps failing, calls are never short circuited when nil
+	Breaker *CircuitBreaker
+	// RoundingMode rounds the amounts converted by ConvertCurrency, defaults to money.DefaultRoundingMode
+	RoundingMode money.RoundingMode
+}
+
+// NewExchangeRateGetter will build the treasury API client with the configured base URL, timeout, retry policy, circuit
+// breaker and rounding mode.
+func NewExchangeRateGetter(cfg *config.ApiConfig) (*ExchangeRateGetter, error) {
+	rounding, err := money.ParseRoundingMode(cfg.Money.RoundingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExchangeRateGetter{
+		BaseURL: cfg.ExchangeRate.TreasuryURL,
+		Timeout: cfg.ExchangeRate.TreasuryTimeout,
+		Retry: RetryPolicy{
+			InitialInterval: cfg.ExchangeRate.TreasuryRetryInitialInterval,
+			MaxInterval:     cfg.ExchangeRate.TreasuryRetryMaxInterval,
+			MaxElapsedTime:  cfg.ExchangeRate.TreasuryRetryMaxElapsedTime,
+			MaxRetries:      cfg.ExchangeRate.TreasuryMaxRetries,
+		},
+		Breaker: &CircuitBreaker{
+			Name:             TreasuryProvider,
+			FailureThreshold: cfg.ExchangeRate.TreasuryBreakerThreshold,
+			OpenTimeout:      cfg.ExchangeRate.TreasuryBreakerOpenTimeout,
+		},
+		RoundingMode: rounding,
+	}, nil
+}
+
+// HttpRequestDoer performs HTTP requests, which is satisfied by *http.Client.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RetryPolicy is the exponential backoff of calls which failed with a transient error. Zero values fall back to the defaults.
+type RetryPolicy struct {
+	// InitialInterval is the backoff after the first failed call, defaults to 500ms
+	InitialInterval time.Duration
+	// MaxInterval caps the backoff between two calls, defaults to 10s
+	MaxInterval time.Duration
+	// MaxElapsedTime is the time after which the call is no longer retried, defaults to 1m
+	MaxElapsedTime time.Duration
+	// MaxRetries is the maximum number of retries, unlimited within MaxElapsedTime when zero
+	MaxRetries int
+}
+
+func (p RetryPolicy) newBackOff(ctx context.Context) *retryBackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = getDurationOrDefault(p.InitialInterval, 500*time.Millisecond)
+	b.MaxInterval = getDurationOrDefault(p.MaxInterval, 10*time.Second)
+	b.MaxElapsedTime = getDurationOrDefault(p.MaxElapsedTime, time.Minute)
+	b.Reset()
+
+	var next backoff.BackOff = b
+	if p.MaxRetries > 0 {
+		next = backoff.WithMaxRetries(b, uint64(p.MaxRetries))
+	}
+
+	return &retryBackOff{BackOff: backoff.WithContext(next, ctx), ctx: ctx, maxWait: b.MaxElapsedTime}
+}
+
+// retryBackOff waits for at least the Retry-After of the last response and stops retrying once the next call would
+// start after the deadline of the request, since the caller would not wait for its response anyway.
+type retryBackOff struct {
+	backoff.BackOff
+
+	ctx        context.Context
+	maxWait    time.Duration
+	retryAfter time.Duration
+}
+
+func (b *retryBackOff) NextBackOff() time.Duration {
+	next := b.BackOff.NextBackOff()
+	if next == backoff.Stop {
+		return backoff.Stop
+	}
+
+	if b.retryAfter > next {
+		next = b.retryAfter
+	}
+	b.retryAfter = 0
+
+	if next > b.maxWait {
+		return backoff.Stop
+	}
+
+	if deadline, ok := b.ctx.Deadline(); ok && time.Now().Add(next).After(deadline) {
+		return backoff.Stop
+	}
+
+	return next
+}
+
+// Context lets the backoff stop waiting as soon as the request is cancelled.
+func (b *retryBackOff) Context() context.Context {
+	return b.ctx
+}
+
+// getRetryAfter will parse the Retry-After header given either in seconds or as an HTTP date.
+func getRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil && time.Until(date) > 0 {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+func getDurationOrDefault(d time.Duration, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+
+	return d
+}
+
+// DefaultTreasuryTimeout is the timeout of a single call to the exchange rate API.
+const DefaultTreasuryTimeout = 10 * time.Second
+
+// defaultTreasuryClient traces every call and injects the W3C traceparent header.
+var defaultTreasuryClient = &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
 
 type ExchangeRatePayload struct {
 	CountryName string
 	Currency    string
 	RecordDate  time.Time
+	// Policy selects the rate among the rates recorded around RecordDate, the default policy of the chain is used when zero
+	Policy RateSelectionPolicy
 }
 
 type ExchangeRateResponse struct {
+	// Country and Currency are only returned when explicitly requested in the fields query param
+	Country             string `json:"country,omitempty"`
+	Currency            string `json:"currency,omitempty"`
 	CountryCurrencyDesc string `json:"country_currency_desc"`
 	ExchangeRate        string `json:"exchange_rate"`
 	RecordDate          string `json:"record_date"`
+
+	// Provider is the name of the exchange rate provider which served this exchange rate
+	Provider string `json:"-"`
+	// Policy is the rate selection policy this exchange rate was selected by
+	Policy RateSelectionPolicy `json:"-"`
 }
 
 type ExchangeRateAPIResponse struct {
-	Data []ExchangeRateResponse `json:"data"`
+	Data  []ExchangeRateResponse `json:"data"`
+	Links ExchangeRateAPILinks   `json:"links"`
+}
+
+// ExchangeRateAPILinks contains the pagination links returned by the exchange rate API.
+// Each link is a query string fragment such as '&page%5Bnumber%5D=2&page%5Bsize%5D=100' which is nil when there is no such page.
+type ExchangeRateAPILinks struct {
+	Next *string `json:"next"`
 }
 
 const TREASURY_RATES_OF_EXCHANGE_API_URL = "https://api.fiscaldata.treasury.gov/services/api/fiscal_service/v1/accounting/od/rates_of_exchange"
 
 func (e *ExchangeRateGetter) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
-
-	req, err := http.NewRequest("GET", TREASURY_RATES_OF_EXCHANGE_API_URL, nil)
+	response, err := e.FetchExchangeRates(ctx, getURLWithRawQueryParms(payload))
 	if err != nil {
 		return ExchangeRateResponse{}, err
 	}
 
-	req.URL.RawQuery = getURLWithRawQueryParms(payload)
+	// for invalid country or currency, API will still return 200 with empty list
+	if len(response.Data) == 0 {
+		return ExchangeRateResponse{}, apiout.NewCodedError(apiout.CodeExchangeRateNotFound, errors.New("the purchase cannot be converted to the target currency, exchange rate API returned empty result"))
+	}
+
+	recordDates := make([]time.Time, 0, len(response.Data))
+	for _, rate := range response.Data {
+		recordDate, err := time.Parse(time.DateOnly, rate.RecordDate)
+		if err != nil {
+			return ExchangeRateResponse{}, apiout.NewCodedError(apiout.CodeExchangeRateUnavailable, errors.New("unable to parse returned record date"))
+		}
+
+		recordDates = append(recordDates, recordDate)
+	}
+
+	// the API already filtered and sorted the rates such that the first one is selected, except for the nearest policy
+	selected := payload.Policy.Select(payload.RecordDate, recordDates)
+	if selected < 0 {
+		slog.DebugContext(ctx, "unable to find currency conversion rate within the rate selection window", "policy", payload.Policy, "record_date", response.Data[0].RecordDate)
+		return ExchangeRateResponse{}, payload.Policy.NotFoundError()
+	}
+
+	return response.Data[selected], nil
+}
+
+// FetchExchangeRates will call the exchange rate API with the given raw query params and return the decoded response.
+// Calls which are rate limited, fail with a server error or time out are retried following the retry policy and the
+// Retry-After of the API, until the deadline of ctx.
+func (e *ExchangeRateGetter) FetchExchangeRates(ctx context.Context, rawQuery string) (ExchangeRateAPIResponse, error) {
+	endpoint, err := url.Parse(e.getBaseURL())
+	if err != nil {
+		return ExchangeRateAPIResponse{}, err
+	}
+
+	endpoint.RawQuery = rawQuery
+
+	slog.DebugContext(ctx, "generated exchange rate API", "url", endpoint)
 
-	client := &http.Client{}
+	if e.Breaker != nil {
+		err = e.Breaker.Allow()
+		if err != nil {
+			return ExchangeRateAPIResponse{}, err
+		}
+	}
+
+	response, err := e.fetchWithRetries(ctx, endpoint.String())
+
+	if e.Breaker != nil {
+		switch {
+		case ctx.Err() != nil:
+			e.Breaker.Release()
+		case isServiceFailure(err):
+			e.Breaker.Failure()
+		default:
+			e.Breaker.Success()
+		}
+	}
+
+	return response, err
+}
 
+func (e *ExchangeRateGetter) fetchWithRetries(ctx context.Context, endpoint string) (ExchangeRateAPIResponse, error) {
 	var resp *http.Response
+	var cancel context.CancelFunc
+	var err error
 
-	slog.Debug("generated exchange rate API", "url", req.URL)
+	b := e.Retry.newBackOff(ctx)
 
-	// since, this API have a rate limiting, we will try to expotentially backoff and retry if we get too many request error from the API.
 	operation := func() error {
-		resp, err = client.Do(req)
+		resp, cancel, err = e.doRequest(ctx, endpoint)
 		if err != nil {
-			return &backoff.PermanentError{}
+			// do not retry once the caller gave up on the request
+			if ctx.Err() != nil {
+				return backoff.Permanent(ctx.Err())
+			}
+
+			return fmt.Errorf("calling the exchange rate service: %w", err)
 		}
 
-		if resp.StatusCode == http.StatusTooManyRequests {
-			return fmt.Errorf("too many request error")
+		if isRetryableStatus(resp.StatusCode) {
+			if resp.StatusCode == http.StatusTooManyRequests {
+				metrics.TreasuryRateLimited.Inc()
+			}
+
+			b.retryAfter = getRetryAfter(resp.Header.Get("Retry-After"))
+
+			resp.Body.Close()
+			cancel()
+
+			return &statusError{status: resp.StatusCode}
 		}
 
 		return nil
 	}
 
-	expBackoff := backoff.NewExponentialBackOff()
-	expBackoff.MaxElapsedTime = 1 * time.Minute
-
-	err = backoff.Retry(operation, expBackoff)
+	err = backoff.RetryNotify(operation, b, func(err error, d time.Duration) {
+		metrics.TreasuryRetries.Inc()
+		slog.InfoContext(ctx, "retrying exchange rate API", "err", err, "backoff", d)
+	})
 	if err != nil {
-		return ExchangeRateResponse{}, err
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			return ExchangeRateAPIResponse{}, apiout.NewCodedError(apiout.CodeExchangeRateServiceUnavailable, fmt.Errorf("the exchange rate service did not respond before the request deadline: %w", ctx.Err()))
+		case ctx.Err() != nil:
+			// the caller can tell its own cancellation apart from the exchange rate service being unavailable
+			return ExchangeRateAPIResponse{}, ctx.Err()
+		}
+
+		slog.WarnContext(ctx, "exchange rate API failed after retries", "err", err)
+
+		return ExchangeRateAPIResponse{}, apiout.NewCodedError(apiout.CodeExchangeRateUnavailable, err)
 	}
+	defer cancel()
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		slog.Debug("exchange request failed", "status_code", resp.StatusCode)
-		return ExchangeRateResponse{}, apiout.NewRequestError(fmt.Errorf("the exchange rate service failed with status code %v", resp.StatusCode), http.StatusInternalServerError)
+		slog.DebugContext(ctx, "exchange request failed", "status_code", resp.StatusCode)
+		return ExchangeRateAPIResponse{}, apiout.NewCodedError(apiout.CodeExchangeRateUnavailable, &statusError{status: resp.StatusCode})
 	}
 
 	var response ExchangeRateAPIResponse
 
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	if err != nil {
-		return ExchangeRateResponse{}, err
+		return ExchangeRateAPIResponse{}, err
 	}
 
-	// for invalid country or currency, API will still return 200 with empty list
-	if len(response.Data) == 0 {
-		return ExchangeRateResponse{}, apiout.NewRequestError(errors.New("the purchase cannot be converted to the target currency, exchange rate API returned empty result"), http.StatusBadRequest)
+	return response, nil
+}
+
+// statusError is the failed response of the exchange rate API.
+type statusError struct {
+	status int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("the exchange rate service failed with status code %v", e.status)
+}
+
+// isServiceFailure reports whether the error is caused by the exchange rate API failing rather than by the request,
+// e.g. a 400 for an invalid filter is not a failure of the service.
+func isServiceFailure(err error) bool {
+	if err == nil {
+		return false
 	}
 
-	if resp != nil {
-		resp.Body.Close()
+	var serr *statusError
+	if errors.As(err, &serr) {
+		return serr.status >= http.StatusInternalServerError || serr.status == http.StatusTooManyRequests
 	}
 
-	// parse string to Date
-	latestedRecordDate, err := time.Parse(time.DateOnly, response.Data[0].RecordDate)
+	var aerr *apiout.APIError
+	if errors.As(err, &aerr) {
+		return aerr.GetCode() == apiout.CodeExchangeRateUnavailable || aerr.GetCode() == apiout.CodeExchangeRateServiceUnavailable
+	}
+
+	return true
+}
+
+// doRequest will make a single call to the exchange rate API bounded by the per call timeout. The returned cancel func
+// must be called once the response body has been read.
+func (e *ExchangeRateGetter) doRequest(ctx context.Context, endpoint string) (*http.Response, context.CancelFunc, error) {
+	timeout := e.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTreasuryTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return ExchangeRateResponse{}, apiout.NewRequestError(errors.New("unable to parse returned record date"), http.StatusInternalServerError)
+		cancel()
+		return nil, nil, backoff.Permanent(err)
+	}
+
+	start := time.Now()
+
+	resp, err := e.getClient().Do(req)
+	if err != nil {
+		cancel()
+		metrics.TreasuryRequestDuration.WithLabelValues("error").Observe(time.Since(start).Seconds())
+
+		return nil, nil, err
+	}
+
+	metrics.TreasuryRequestDuration.WithLabelValues(strconv.Itoa(resp.StatusCode)).Observe(time.Since(start).Seconds())
+
+	return resp, cancel, nil
+}
+
+func (e *ExchangeRateGetter) getBaseURL() string {
+	if e.BaseURL == "" {
+		return TREASURY_RATES_OF_EXCHANGE_API_URL
+	}
+
+	return e.BaseURL
+}
+
+func (e *ExchangeRateGetter) getClient() HttpRequestDoer {
+	if e.Client == nil {
+		return defaultTreasuryClient
 	}
 
-	// currency conversion rate can be less than or equal to purchase date from within the last 6 months
-	sixMonthBeforePurchaseDate := getSixMonthBeforePurchaseDate(payload.RecordDate)
+	return e.Client
+}
 
-	if latestedRecordDate.Before(sixMonthBeforePurchaseDate) {
-		slog.Debug("unable to find currency conversion rate within last 6 months", "latest_date", latestedRecordDate)
-		return ExchangeRateResponse{}, apiout.NewRequestError(errors.New("the purchase cannot be converted to the target currency, unable to find currency converson rate within last 6 months"), http.StatusBadRequest)
+// isRetryableStatus reports whether the call failed with a transient error, such as the rate limit of the API.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
 	}
+}
 
-	// we can return the first item since we have already sorted our API response to our need.
-	return response.Data[0], nil
+// Name of the exchange rate provider.
+func (e *ExchangeRateGetter) Name() string {
+	return TreasuryProvider
 }
 
 // ConvertCurrency will return the converted purchase amount for the provided purchase price in USD to exchange rate information.
-func (e *ExchangeRateGetter) ConvertCurrency(payload ExchangeRatePayload, trans *ent.Transaction, er ExchangeRateResponse) (types.GetPurchaseTransaction, error) {
+// Purchases made in another currency are converted through USD from their original amount instead, see convertCurrency.
+// The converted amount is rounded with the rounding mode of the getter.
+func (e *ExchangeRateGetter) ConvertCurrency(ctx context.Context, payload ExchangeRatePayload, trans *ent.Transaction, er ExchangeRateResponse) (types.GetPurchaseTransaction, error) {
+	return convertCurrency(ctx, e, payload, trans, er, e.RoundingMode)
+}
+
+// convertCurrency will convert the purchase with the exchange rate of the target currency. A purchase made in a currency other
+// than USD is triangulated through USD: the exchange rate of its original currency is looked up for the same record date and
+// the original amount is converted with both rates, such that the amount does not suffer from amountInUSD being rounded to cents.
+// The converted amount is rounded once to the minor units of the target currency with the rounding mode.
+func convertCurrency(ctx context.Context, getter exchangeRateGetter, payload ExchangeRatePayload, trans *ent.Transaction, er ExchangeRateResponse, rounding money.RoundingMode) (types.GetPurchaseTransaction, error) {
 	exchangeRate, err := decimal.NewFromString(er.ExchangeRate)
 	if err != nil {
 		return types.GetPurchaseTransaction{}, err
 	}
 
 	country := strings.Trim(payload.CountryName, "\"")
-	currency := strings.Trim(payload.Currency, "\"")
+	currencyName := strings.Trim(payload.Currency, "\"")
+
+	leg, err := getSourceLeg(ctx, getter, trans, er)
+	if err != nil {
+		return types.GetPurchaseTransaction{}, err
+	}
 
 	convertedAmount := convertAmount(trans.AmountInUsd, exchangeRate)
+	if leg != nil {
+		sourceRate, err := decimal.NewFromString(leg.rate.ExchangeRate)
+		if err != nil {
+			return types.GetPurchaseTransaction{}, err
+		}
+
+		convertedAmount, err = triangulateAmount(leg.amount.Amount, sourceRate, exchangeRate)
+		if err != nil {
+			return types.GetPurchaseTransaction{}, err
+		}
+	}
+
+	// the amount is rounded to cents when the currency of the target is not known
+	d, known := currency.Lookup(country, currencyName)
+	converted := money.New(convertedAmount, d.CurrencyCode).Round(rounding)
 
 	response := types.GetPurchaseTransaction{
-		TransactionDetails: types.Transaction{
-			AmountInUSD: trans.AmountInUsd.String(),
-			Date:        trans.Date,
-			Description: trans.Description,
-			Id:          trans.ID.String(),
-		},
+		TransactionDetails: GetTransactionDetails(trans),
 		ConvertedDetails: types.ConvertedPurchasePrice{
-			Amount:           RoundToNearestCent(convertedAmount).String(),
+			Amount:           converted.Amount.String(),
 			Country:          country,
-			Currency:         currency,
+			Currency:         currencyName,
 			ExchangeRateUsed: er.ExchangeRate,
 			ExchangeRateDate: er.RecordDate,
 		},
 	}
 
-	return response, nil
-}
+	if er.Provider != "" {
+		response.ConvertedDetails.Provider = &er.Provider
+	}
+
+	ratePolicy := types.RatePolicy(er.Policy.GetMode())
+	response.ConvertedDetails.RatePolicy = &ratePolicy
+
+	roundingMode := types.RoundingMode(rounding.GetMode())
+	response.ConvertedDetails.RoundingMode = &roundingMode
+
+	countryCurrencyDesc := getCountryCurrencyDesc(payload)
+	response.ConvertedDetails.CountryCurrencyDesc = &countryCurrencyDesc
+
+	if known {
+		response.ConvertedDetails.CountryCode = &d.CountryCode
+		response.ConvertedDetails.CurrencyCode = &d.CurrencyCode
+	}
+
+	if leg != nil {
+		response.ConvertedDetails.SourceAmount = ptr(leg.amount.Amount.String())
+		response.ConvertedDetails.SourceCurrency = ptr(leg.amount.Currency)
+		response.ConvertedDetails.SourceExchangeRateUsed = ptr(leg.rate.ExchangeRate)
+		response.ConvertedDetails.SourceExchangeRateDate = ptr(leg.rate.RecordDate)
+	}
 
-func getSixMonthBeforePurchaseDate(d time.Time) time.Time {
-	return d.AddDate(0, -6, 0)
+	return response, nil
 }
 
 func convertAmount(original decimal.Decimal, exchangeRate decimal.Decimal) decimal.Decimal {
 	return original.Mul(exchangeRate)
 }
 
-// getURLWithRawQueryParams will generate required query param for our exchange rate API call.
-func getURLWithRawQueryParms(payload ExchangeRatePayload) string {
+// getCountryAndCurrency will return the country and currency of the payload without any surrounding quotes.
+func getCountryAndCurrency(payload ExchangeRatePayload) (string, string) {
 	// remove " quotes from our query params
 	country := strings.Trim(payload.CountryName, "\"")
 	country = strings.Trim(country, "'")
 	currency := strings.Trim(payload.Currency, "\"")
 	currency = strings.Trim(currency, "'")
 
-	filter := fmt.Sprintf("record_date:lte:%s,country_currency_desc:eq:%s-%s", payload.RecordDate.Format(time.DateOnly), url.QueryEscape(country), url.QueryEscape(currency))
+	return country, currency
+}
+
+// getURLWithRawQueryParams will generate required query param for our exchange rate API call.
+func getURLWithRawQueryParms(payload ExchangeRatePayload) string {
+	country, currency := getCountryAndCurrency(payload)
+	countryCurrencyDesc := fmt.Sprintf("country_currency_desc:eq:%s-%s", url.QueryEscape(country), url.QueryEscape(currency))
+
+	from, to := payload.Policy.Window(payload.RecordDate)
+	pageSize := 1
+
+	var filter, sort string
+
+	switch payload.Policy.GetMode() {
+	case Nearest:
+		// the nearest rate may be on either side of the purchase date, thus every rate within the window is selected from
+		filter = fmt.Sprintf("record_date:gte:%s,record_date:lte:%s,%s", from.Format(time.DateOnly), to.Format(time.DateOnly), countryCurrencyDesc)
+		sort = "record_date"
+		pageSize = historyPageSize
+	case SameQuarter:
+		filter = fmt.Sprintf("record_date:gte:%s,record_date:lte:%s,%s", from.Format(time.DateOnly), to.Format(time.DateOnly), countryCurrencyDesc)
+		sort = "-record_date"
+	case EarliestAfter:
+		filter = fmt.Sprintf("record_date:gte:%s,%s", from.Format(time.DateOnly), countryCurrencyDesc)
+		sort = "record_date"
+	default:
+		filter = fmt.Sprintf("record_date:lte:%s,%s", payload.RecordDate.Format(time.DateOnly), countryCurrencyDesc)
+		// sort by record_date in descending order such that we will get the first item which is closest to our purchase date within the lookback window
+		sort = "-record_date"
+	}
+
 	fields := "country_currency_desc,exchange_rate,record_date"
-	// sort by record_date in descending order such that we will get the first item which is closest to our purchase date within last six months
-	sort := "-record_date"
 
-	output := fmt.Sprintf("filter=%s&fields=%s&sort=%s&page[size]=1", filter, fields, sort)
+	output := fmt.Sprintf("filter=%s&fields=%s&sort=%s&page[size]=%d", filter, fields, sort, pageSize)
 
 	return output
 }
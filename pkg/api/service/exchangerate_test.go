@@ -2,11 +2,20 @@ package service
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/config"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/eddie023/wex-tag/pkg/treasurytest"
+	"github.com/eddie023/wex-tag/pkg/types"
 	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
 )
 
 func TestGetExchangeRate(t *testing.T) {
@@ -61,10 +70,40 @@ func TestGetExchangeRate(t *testing.T) {
 			want:    ExchangeRateResponse{},
 			wantErr: true,
 		},
+		{
+			name:         "should return nearest exchange rate for nearest policy",
+			purchaseDate: "2022-12-01",
+			payload: ExchangeRatePayload{
+				CountryName: "Nepal",
+				Currency:    "Rupee",
+				Policy:      RateSelectionPolicy{Mode: Nearest},
+			},
+			want: ExchangeRateResponse{
+				CountryCurrencyDesc: "Nepal-Rupee",
+				ExchangeRate:        "132.5",
+				RecordDate:          "2022-12-31",
+			},
+		},
+		{
+			name:         "should return earliest exchange rate after purchase date for earliest after policy",
+			purchaseDate: "2022-07-01",
+			payload: ExchangeRatePayload{
+				CountryName: "Nepal",
+				Currency:    "Rupee",
+				Policy:      RateSelectionPolicy{Mode: EarliestAfter},
+			},
+			want: ExchangeRateResponse{
+				CountryCurrencyDesc: "Nepal-Rupee",
+				ExchangeRate:        "130.5",
+				RecordDate:          "2022-09-30",
+			},
+		},
 	}
+	server := treasurytest.NewServer(t)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			e := &ExchangeRateGetter{}
+			e := &ExchangeRateGetter{BaseURL: server.URL}
 
 			recordDate, err := time.Parse(time.DateOnly, tt.purchaseDate)
 			if err != nil {
@@ -75,6 +114,7 @@ func TestGetExchangeRate(t *testing.T) {
 				CountryName: tt.payload.CountryName,
 				Currency:    tt.payload.Currency,
 				RecordDate:  recordDate,
+				Policy:      tt.payload.Policy,
 			})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("got error = %v, wantErr %v", err, tt.wantErr)
@@ -88,41 +128,213 @@ func TestGetExchangeRate(t *testing.T) {
 	}
 }
 
-func TestGetSixMonthBeforePurchaseDate(t *testing.T) {
+func TestFetchExchangeRates(t *testing.T) {
+	retry := RetryPolicy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, MaxRetries: 3}
+
 	tests := []struct {
-		given string
-		want  string
-		name  string
+		name    string
+		setup   func(s *treasurytest.Server)
+		timeout time.Duration
+
+		wantRequests int
+		wantErr      bool
 	}{
 		{
-			name:  "should successfully decrement year",
-			given: "2023-06-30",
-			want:  "2022-12-30",
+			name:         "should return exchange rates",
+			setup:        func(s *treasurytest.Server) {},
+			wantRequests: 1,
+		},
+		{
+			name:         "should retry rate limited calls",
+			setup:        func(s *treasurytest.Server) { s.RateLimit(2) },
+			wantRequests: 3,
+		},
+		{
+			name:         "should retry calls failed with transient errors",
+			setup:        func(s *treasurytest.Server) { s.Fail(http.StatusServiceUnavailable, 1) },
+			wantRequests: 2,
+		},
+		{
+			name:         "should fail after the maximum number of retries",
+			setup:        func(s *treasurytest.Server) { s.RateLimit(10) },
+			wantRequests: 4,
+			wantErr:      true,
 		},
 		{
-			name:  "should correctly return 6 months before",
-			given: "2023-02-28",
-			want:  "2022-08-28",
+			name:         "should not retry client errors",
+			setup:        func(s *treasurytest.Server) { s.Fail(http.StatusBadRequest, 1) },
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "should retry calls which timed out",
+			setup:        func(s *treasurytest.Server) { s.SetDelay(time.Second) },
+			timeout:      10 * time.Millisecond,
+			wantRequests: 4,
+			wantErr:      true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			server := treasurytest.NewServer(t)
+			tt.setup(server)
 
-			givenDate, err := time.Parse(time.DateOnly, tt.given)
-			if err != nil {
-				t.Fatal()
+			e := &ExchangeRateGetter{BaseURL: server.URL, Timeout: tt.timeout, Retry: retry}
+
+			got, err := e.FetchExchangeRates(context.TODO(), "filter=country:eq:Nepal")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error = %v, wantErr %v", err, tt.wantErr)
 			}
-			sixMonthBefore := getSixMonthBeforePurchaseDate(givenDate)
 
-			got := sixMonthBefore.Format(time.DateOnly)
+			assert.Equal(t, tt.wantRequests, server.Requests())
 
-			if got != tt.want {
-				t.Errorf("getSixMonthBeforePurchaseDate() = %s, want %s", got, tt.want)
+			if !tt.wantErr {
+				assert.Equal(t, 3, len(got.Data))
 			}
 		})
 	}
 }
 
+func TestFetchExchangeRatesCancelled(t *testing.T) {
+	server := treasurytest.NewServer(t)
+	server.SetDelay(time.Second)
+
+	e := &ExchangeRateGetter{BaseURL: server.URL, Retry: RetryPolicy{InitialInterval: time.Millisecond}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err := e.FetchExchangeRates(ctx, "")
+	assert.Assert(t, errors.Is(err, context.DeadlineExceeded), "got error = %v", err)
+	assert.Assert(t, time.Since(start) < time.Second, "call was not cancelled")
+	assert.Equal(t, 1, server.Requests())
+}
+
+func TestFetchExchangeRatesRetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		timeout    time.Duration
+
+		wantRequests int
+		wantCode     apiout.ErrorCode
+	}{
+		{
+			name:         "should wait for the Retry-After before retrying",
+			retryAfter:   "1",
+			wantRequests: 2,
+		},
+		{
+			name:         "should not retry when Retry-After is after the request deadline",
+			retryAfter:   "1",
+			timeout:      200 * time.Millisecond,
+			wantRequests: 1,
+			wantCode:     apiout.CodeExchangeRateUnavailable,
+		},
+		{
+			name:         "should not retry when Retry-After exceeds the maximum elapsed time",
+			retryAfter:   "3600",
+			wantRequests: 1,
+			wantCode:     apiout.CodeExchangeRateUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := treasurytest.NewServer(t)
+			server.RateLimit(1)
+			server.SetRetryAfter(tt.retryAfter)
+
+			e := &ExchangeRateGetter{BaseURL: server.URL, Retry: RetryPolicy{InitialInterval: time.Millisecond, MaxElapsedTime: 5 * time.Second}}
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+
+			_, err := e.FetchExchangeRates(ctx, "filter=country:eq:Nepal")
+			assert.Equal(t, tt.wantRequests, server.Requests())
+
+			if tt.wantCode == "" {
+				assert.NilError(t, err)
+				assert.Assert(t, time.Since(start) >= time.Second, "retried before Retry-After")
+
+				return
+			}
+
+			var aerr *apiout.APIError
+			assert.Assert(t, errors.As(err, &aerr), "got error = %v", err)
+			assert.Equal(t, tt.wantCode, aerr.GetCode())
+			assert.Assert(t, time.Since(start) < time.Second, "waited for Retry-After")
+		})
+	}
+}
+
+func TestFetchExchangeRatesCircuitBreaker(t *testing.T) {
+	server := treasurytest.NewServer(t)
+	server.Fail(http.StatusServiceUnavailable, 100)
+
+	e := &ExchangeRateGetter{
+		BaseURL: server.URL,
+		Retry:   RetryPolicy{InitialInterval: time.Millisecond, MaxRetries: 1},
+		Breaker: &CircuitBreaker{Name: "test", FailureThreshold: 2, OpenTimeout: time.Minute},
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := e.FetchExchangeRates(context.TODO(), "")
+		assert.Assert(t, err != nil)
+	}
+
+	assert.Equal(t, 4, server.Requests())
+	assert.Equal(t, CircuitOpen, e.Breaker.State())
+
+	// open circuit fails fast without calling the API
+	_, err := e.FetchExchangeRates(context.TODO(), "")
+
+	var aerr *apiout.APIError
+	assert.Assert(t, errors.As(err, &aerr), "got error = %v", err)
+	assert.Equal(t, apiout.CodeExchangeRateServiceUnavailable, aerr.GetCode())
+	assert.Equal(t, http.StatusServiceUnavailable, aerr.GetHttpStatus())
+	assert.Equal(t, 4, server.Requests())
+}
+
+func TestFetchExchangeRatesClientErrorKeepsCircuitClosed(t *testing.T) {
+	server := treasurytest.NewServer(t)
+	server.Fail(http.StatusBadRequest, 100)
+
+	e := &ExchangeRateGetter{
+		BaseURL: server.URL,
+		Breaker: &CircuitBreaker{Name: "test", FailureThreshold: 1},
+	}
+
+	_, err := e.FetchExchangeRates(context.TODO(), "")
+	assert.Assert(t, err != nil)
+	assert.Equal(t, CircuitClosed, e.Breaker.State())
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		given string
+		want  time.Duration
+	}{
+		{name: "should parse seconds", given: "3", want: 3 * time.Second},
+		{name: "should ignore missing header", given: "", want: 0},
+		{name: "should ignore invalid header", given: "soon", want: 0},
+		{name: "should ignore date in the past", given: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getRetryAfter(tt.given))
+		})
+	}
+}
+
 func TestConvertAmount(t *testing.T) {
 	type args struct {
 		original     decimal.Decimal
@@ -161,6 +373,61 @@ func TestConvertAmount(t *testing.T) {
 	}
 }
 
+func TestConvertCurrencyRounding(t *testing.T) {
+	trans := &ent.Transaction{Date: time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC), AmountInUsd: decimal.RequireFromString("10.05")}
+
+	tests := []struct {
+		name     string
+		payload  ExchangeRatePayload
+		rate     string
+		rounding money.RoundingMode
+		want     string
+	}{
+		{name: "should round half up to cents", payload: ExchangeRatePayload{CountryName: "Euro Zone", Currency: "Euro"}, rate: "0.9", rounding: money.HalfUp, want: "9.05"},
+		{name: "should round half to even cents", payload: ExchangeRatePayload{CountryName: "Euro Zone", Currency: "Euro"}, rate: "0.9", rounding: money.HalfEven, want: "9.04"},
+		{name: "should round down to cents", payload: ExchangeRatePayload{CountryName: "Euro Zone", Currency: "Euro"}, rate: "0.9", rounding: money.Down, want: "9.04"},
+		{name: "should round to whole yen", payload: ExchangeRatePayload{CountryName: "Japan", Currency: "Yen"}, rate: "149.5", rounding: money.HalfUp, want: "1502"},
+		{name: "should round to thousandths of dinar", payload: ExchangeRatePayload{CountryName: "Kuwait", Currency: "Dinar"}, rate: "0.30795", rounding: money.HalfUp, want: "3.095"},
+		{name: "should round down to thousandths of dinar", payload: ExchangeRatePayload{CountryName: "Kuwait", Currency: "Dinar"}, rate: "0.30795", rounding: money.Down, want: "3.094"},
+		{name: "should round to cents for unknown currency", payload: ExchangeRatePayload{CountryName: "Atlantis", Currency: "Shell"}, rate: "1.2345", want: "12.41"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er := ExchangeRateResponse{ExchangeRate: tt.rate, RecordDate: "2023-09-30"}
+
+			getter := &ExchangeRateGetter{RoundingMode: tt.rounding}
+
+			got, err := getter.ConvertCurrency(context.TODO(), tt.payload, trans, er)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got.ConvertedDetails.Amount)
+			assert.Equal(t, types.RoundingMode(tt.rounding.GetMode()), *got.ConvertedDetails.RoundingMode)
+		})
+	}
+}
+
+func TestNewExchangeRateGetter(t *testing.T) {
+	cfg := &config.ApiConfig{}
+	cfg.ExchangeRate.TreasuryURL = "http://treasury.test"
+	cfg.ExchangeRate.TreasuryTimeout = 5 * time.Second
+	cfg.ExchangeRate.TreasuryMaxRetries = 2
+	cfg.ExchangeRate.TreasuryBreakerThreshold = 3
+	cfg.Money.RoundingMode = "Half_Even"
+
+	getter, err := NewExchangeRateGetter(cfg)
+	assert.NilError(t, err)
+	assert.Equal(t, "http://treasury.test", getter.BaseURL)
+	assert.Equal(t, 5*time.Second, getter.Timeout)
+	assert.Equal(t, 2, getter.Retry.MaxRetries)
+	assert.Equal(t, TreasuryProvider, getter.Breaker.Name)
+	assert.Equal(t, 3, getter.Breaker.FailureThreshold)
+	assert.Equal(t, money.HalfEven, getter.RoundingMode)
+
+	cfg.Money.RoundingMode = "ceiling"
+
+	_, err = NewExchangeRateGetter(cfg)
+	assert.ErrorContains(t, err, "unknown rounding mode")
+}
+
 func TestGetURLWithRawQueryParms(t *testing.T) {
 
 	tests := []struct {
@@ -192,6 +459,36 @@ func TestGetURLWithRawQueryParms(t *testing.T) {
 			},
 			want: "filter=record_date:lte:0001-01-01,country_currency_desc:eq:United+Kingdom-Pound&fields=country_currency_desc,exchange_rate,record_date&sort=-record_date&page[size]=1",
 		},
+		{
+			name: "should select every rate within the window for nearest policy",
+			given: ExchangeRatePayload{
+				CountryName: "Nepal",
+				Currency:    "Rupee",
+				RecordDate:  time.Date(2022, 11, 15, 0, 0, 0, 0, time.UTC),
+				Policy:      RateSelectionPolicy{Mode: Nearest, LookbackMonths: 3},
+			},
+			want: "filter=record_date:gte:2022-08-15,record_date:lte:2023-02-15,country_currency_desc:eq:Nepal-Rupee&fields=country_currency_desc,exchange_rate,record_date&sort=record_date&page[size]=1000",
+		},
+		{
+			name: "should select latest rate within the quarter for same quarter policy",
+			given: ExchangeRatePayload{
+				CountryName: "Nepal",
+				Currency:    "Rupee",
+				RecordDate:  time.Date(2022, 11, 30, 0, 0, 0, 0, time.UTC),
+				Policy:      RateSelectionPolicy{Mode: SameQuarter},
+			},
+			want: "filter=record_date:gte:2022-10-01,record_date:lte:2022-12-31,country_currency_desc:eq:Nepal-Rupee&fields=country_currency_desc,exchange_rate,record_date&sort=-record_date&page[size]=1",
+		},
+		{
+			name: "should select earliest rate on or after purchase date for earliest after policy",
+			given: ExchangeRatePayload{
+				CountryName: "Nepal",
+				Currency:    "Rupee",
+				RecordDate:  time.Date(2022, 11, 30, 0, 0, 0, 0, time.UTC),
+				Policy:      RateSelectionPolicy{Mode: EarliestAfter},
+			},
+			want: "filter=record_date:gte:2022-11-30,country_currency_desc:eq:Nepal-Rupee&fields=country_currency_desc,exchange_rate,record_date&sort=record_date&page[size]=1",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+)
+
+// default bounds of the exchange rate cache
+const (
+	DefaultExchangeRateCacheSize = 1000
+	DefaultExchangeRateCacheTTL  = time.Hour
+)
+
+// ExchangeRateCache is an ExchangeRateProvider which keeps the exchange rates returned by the wrapped provider in a
+// bounded in-memory cache with a TTL, such that purchases converted on the same quarter do not each call the provider.
+// Concurrent identical lookups which miss the cache share a single call to the wrapped provider.
+//
+// Rates are cached by country_currency_desc and the quarter-end record date on or before the purchase date. Treasury
+// publishes the rates at the end of every quarter, thus only rates recorded on the quarter-end are cached since they are
+// the answer for any purchase made until the next quarter-end. Rates selected by the other policies are cached by the
+// quarter or the day of the purchase instead, see getCacheKey.
+type ExchangeRateCache struct {
+	Provider ExchangeRateProvider
+	// Size is the maximum number of cached rates, the least recently used rate is evicted first
+	Size int
+	// TTL is how long a cached rate is served before it is looked up again
+	TTL time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	group   singleflight.Group
+}
+
+type exchangeRateCacheEntry struct {
+	key       string
+	response  ExchangeRateResponse
+	expiresAt time.Time
+}
+
+// NewExchangeRateCache will wrap the provider with a cache of the given size and TTL. Zero values fall back to the defaults.
+func NewExchangeRateCache(provider ExchangeRateProvider, size int, ttl time.Duration) *ExchangeRateCache {
+	if size <= 0 {
+		size = DefaultExchangeRateCacheSize
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultExchangeRateCacheTTL
+	}
+
+	return &ExchangeRateCache{Provider: provider, Size: size, TTL: ttl}
+}
+
+func (c *ExchangeRateCache) Name() string {
+	return c.Provider.Name()
+}
+
+func (c *ExchangeRateCache) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	key, isCacheable := getCacheKey(payload)
+
+	span := trace.SpanFromContext(ctx)
+
+	// a cached rate may have been selected within a wider lookback window than the one requested
+	if response, ok := c.get(key); ok && isWithinPolicyWindow(payload, response) {
+		metrics.ExchangeRateCacheHits.WithLabelValues(c.Name()).Inc()
+		span.SetAttributes(attribute.Bool("exchange_rate.cache_hit", true))
+
+		return response, nil
+	}
+
+	metrics.ExchangeRateCacheMisses.WithLabelValues(c.Name()).Inc()
+	span.SetAttributes(attribute.Bool("exchange_rate.cache_hit", false))
+
+	// only identical lookups are coalesced since the wrapped provider answers by the exact purchase date and policy
+	flightKey := fmt.Sprintf("%s|%s|%s", getCountryCurrencyDesc(payload), payload.RecordDate.Format(time.DateOnly), payload.Policy)
+
+	// the shared lookup must not be cancelled when the caller which started it goes away
+	flightCtx := context.WithoutCancel(ctx)
+
+	ch := c.group.DoChan(flightKey, func() (interface{}, error) {
+		response, err := c.Provider.GetExchangeRate(flightCtx, payload)
+		if err != nil {
+			return ExchangeRateResponse{}, err
+		}
+
+		if isCacheable(response) {
+			c.add(key, response)
+		}
+
+		return response, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return ExchangeRateResponse{}, ctx.Err()
+	case result := <-ch:
+		if result.Shared {
+			metrics.ExchangeRateLookupsCoalesced.WithLabelValues(c.Name()).Inc()
+		}
+
+		if result.Err != nil {
+			return ExchangeRateResponse{}, result.Err
+		}
+
+		return result.Val.(ExchangeRateResponse), nil
+	}
+}
+
+// getCacheKey will return the key the rate of the payload is cached by and whether the rate returned for it may be cached.
+func getCacheKey(payload ExchangeRatePayload) (string, func(response ExchangeRateResponse) bool) {
+	countryCurrencyDesc := getCountryCurrencyDesc(payload)
+	mode := payload.Policy.GetMode()
+
+	switch mode {
+	case SameQuarter:
+		quarterStart := getQuarterStart(payload.RecordDate)
+		quarterEnd := quarterStart.AddDate(0, 3, -1).Format(time.DateOnly)
+
+		// the rate recorded on the quarter-end is the latest of the quarter
+		return countryCurrencyDesc + "|" + string(mode) + "|" + quarterStart.Format(time.DateOnly), func(response ExchangeRateResponse) bool {
+			return response.RecordDate == quarterEnd
+		}
+	case Nearest, EarliestAfter:
+		_, to := payload.Policy.Window(payload.RecordDate)
+
+		// rates published later may still be nearer to the purchase until the window has passed
+		return countryCurrencyDesc + "|" + string(mode) + "|" + payload.RecordDate.Format(time.DateOnly), func(response ExchangeRateResponse) bool {
+			return mode == EarliestAfter || to.Before(time.Now())
+		}
+	default:
+		quarterEnd := getQuarterEndOnOrBefore(payload.RecordDate).Format(time.DateOnly)
+
+		return countryCurrencyDesc + "|" + quarterEnd, func(response ExchangeRateResponse) bool {
+			return response.RecordDate == quarterEnd
+		}
+	}
+}
+
+// isWithinPolicyWindow reports whether the policy of the payload may select the rate.
+func isWithinPolicyWindow(payload ExchangeRatePayload, response ExchangeRateResponse) bool {
+	recordDate, err := time.Parse(time.DateOnly, response.RecordDate)
+	if err != nil {
+		return false
+	}
+
+	return payload.Policy.InWindow(payload.RecordDate, recordDate)
+}
+
+// Len returns the number of cached rates, including the expired ones which were not evicted yet.
+func (c *ExchangeRateCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lru == nil {
+		return 0
+	}
+
+	return c.lru.Len()
+}
+
+func (c *ExchangeRateCache) get(key string) (ExchangeRateResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return ExchangeRateResponse{}, false
+	}
+
+	entry := el.Value.(*exchangeRateCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.lru.Remove(el)
+		delete(c.entries, key)
+
+		return ExchangeRateResponse{}, false
+	}
+
+	c.lru.MoveToFront(el)
+
+	return entry.response, true
+}
+
+func (c *ExchangeRateCache) add(key string, response ExchangeRateResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+		c.lru = list.New()
+	}
+
+	entry := &exchangeRateCacheEntry{key: key, response: response, expiresAt: time.Now().Add(c.getTTL())}
+
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.lru.MoveToFront(el)
+
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.getSize() {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*exchangeRateCacheEntry).key)
+	}
+}
+
+func (c *ExchangeRateCache) getSize() int {
+	if c.Size <= 0 {
+		return DefaultExchangeRateCacheSize
+	}
+
+	return c.Size
+}
+
+func (c *ExchangeRateCache) getTTL() time.Duration {
+	if c.TTL <= 0 {
+		return DefaultExchangeRateCacheTTL
+	}
+
+	return c.TTL
+}
+
+// getQuarterEndOnOrBefore will return the latest quarter-end i.e. 31 Mar, 30 Jun, 30 Sep or 31 Dec on or before the date.
+func getQuarterEndOnOrBefore(d time.Time) time.Time {
+	quarterStart := getQuarterStart(d)
+	nextQuarterStart := quarterStart.AddDate(0, 3, 0)
+
+	// the date is itself the end of its quarter
+	if !d.Before(nextQuarterStart.AddDate(0, 0, -1)) {
+		return nextQuarterStart.AddDate(0, 0, -1)
+	}
+
+	return quarterStart.AddDate(0, 0, -1)
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"github.com/eddie023/wex-tag/pkg/treasurytest"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/assert"
+)
+
+type countingExchangeRateProvider struct {
+	calls atomic.Int32
+}
+
+func (p *countingExchangeRateProvider) Name() string {
+	return "counting"
+}
+
+func (p *countingExchangeRateProvider) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	p.calls.Add(1)
+
+	// the latest quarter-end the policy may select
+	_, to := payload.Policy.Window(payload.RecordDate)
+
+	return ExchangeRateResponse{
+		CountryCurrencyDesc: getCountryCurrencyDesc(payload),
+		ExchangeRate:        "1.5",
+		RecordDate:          getQuarterEndOnOrBefore(to).Format(time.DateOnly),
+	}, nil
+}
+
+func TestExchangeRateCache(t *testing.T) {
+	tests := []struct {
+		name          string
+		size          int
+		ttl           time.Duration
+		purchaseDates []string
+		currencies    []string
+		policy        RateSelectionPolicy
+		wantCalls     int32
+	}{
+		{
+			name:          "should answer purchases of the same quarter from the cache",
+			purchaseDates: []string{"2022-10-01", "2022-11-30", "2022-12-30"},
+			currencies:    []string{"Rupee"},
+			wantCalls:     1,
+		},
+		{
+			name:          "should look up purchases of another quarter",
+			purchaseDates: []string{"2022-11-30", "2022-12-31"},
+			currencies:    []string{"Rupee"},
+			wantCalls:     2,
+		},
+		{
+			name:          "should evict least recently used rate",
+			size:          1,
+			purchaseDates: []string{"2022-11-30"},
+			currencies:    []string{"Rupee", "Dollar", "Rupee"},
+			wantCalls:     3,
+		},
+		{
+			name:          "should look up expired rate again",
+			ttl:           time.Nanosecond,
+			purchaseDates: []string{"2022-11-30", "2022-11-30"},
+			currencies:    []string{"Rupee"},
+			wantCalls:     2,
+		},
+		{
+			name:          "should look up cached rate outside the lookback window again",
+			purchaseDates: []string{"2022-10-01", "2022-11-30"},
+			currencies:    []string{"Rupee"},
+			policy:        RateSelectionPolicy{LookbackMonths: 1},
+			wantCalls:     2,
+		},
+		{
+			name:          "should answer purchases of the same quarter from the cache for same quarter policy",
+			purchaseDates: []string{"2022-10-01", "2022-11-30", "2022-12-31"},
+			currencies:    []string{"Rupee"},
+			policy:        RateSelectionPolicy{Mode: SameQuarter},
+			wantCalls:     1,
+		},
+		{
+			name:          "should answer purchases of the same day from the cache for earliest after policy",
+			purchaseDates: []string{"2022-10-01", "2022-10-01", "2022-11-30"},
+			currencies:    []string{"Rupee"},
+			policy:        RateSelectionPolicy{Mode: EarliestAfter},
+			wantCalls:     2,
+		},
+		{
+			name:          "should answer purchases of the same day from the cache for nearest policy",
+			purchaseDates: []string{"2022-10-01", "2022-10-01"},
+			currencies:    []string{"Rupee"},
+			policy:        RateSelectionPolicy{Mode: Nearest},
+			wantCalls:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &countingExchangeRateProvider{}
+			cache := NewExchangeRateCache(provider, tt.size, tt.ttl)
+
+			for _, currency := range tt.currencies {
+				for _, purchaseDate := range tt.purchaseDates {
+					recordDate, err := time.Parse(time.DateOnly, purchaseDate)
+					if err != nil {
+						t.Fatal()
+					}
+
+					_, err = cache.GetExchangeRate(context.TODO(), ExchangeRatePayload{CountryName: "Nepal", Currency: currency, RecordDate: recordDate, Policy: tt.policy})
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			assert.Equal(t, tt.wantCalls, provider.calls.Load())
+			assert.Assert(t, cache.Len() <= cache.getSize())
+		})
+	}
+}
+
+func TestExchangeRateCacheCoalescesLookups(t *testing.T) {
+	server := treasurytest.NewServer(t)
+	server.SetDelay(50 * time.Millisecond)
+
+	cache := NewExchangeRateCache(&ExchangeRateGetter{BaseURL: server.URL}, 0, 0)
+
+	purchaseDate, err := time.Parse(time.DateOnly, "2022-11-30")
+	if err != nil {
+		t.Fatal()
+	}
+
+	before := testutil.ToFloat64(metrics.ExchangeRateCacheHits.WithLabelValues(TreasuryProvider))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			got, err := cache.GetExchangeRate(context.TODO(), ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee", RecordDate: purchaseDate})
+			assert.NilError(t, err)
+			assert.Equal(t, "130.5", got.ExchangeRate)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, server.Requests())
+
+	// the rate recorded on the quarter-end is now served from the cache
+	_, err = cache.GetExchangeRate(context.TODO(), ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee", RecordDate: purchaseDate.AddDate(0, 0, -1)})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, server.Requests())
+	assert.Equal(t, before+1, testutil.ToFloat64(metrics.ExchangeRateCacheHits.WithLabelValues(TreasuryProvider)))
+}
+
+func TestGetQuarterEndOnOrBefore(t *testing.T) {
+	tests := []struct {
+		given string
+		want  string
+	}{
+		{given: "2022-11-30", want: "2022-09-30"},
+		{given: "2022-09-30", want: "2022-09-30"},
+		{given: "2022-10-01", want: "2022-09-30"},
+		{given: "2023-02-15", want: "2022-12-31"},
+		{given: "2023-03-31", want: "2023-03-31"},
+		{given: "2023-06-29", want: "2023-03-31"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.given, func(t *testing.T) {
+			given, err := time.Parse(time.DateOnly, tt.given)
+			if err != nil {
+				t.Fatal()
+			}
+
+			assert.Equal(t, tt.want, getQuarterEndOnOrBefore(given).Format(time.DateOnly))
+		})
+	}
+}
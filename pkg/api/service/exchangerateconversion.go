@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/eddie023/wex-tag/pkg/types"
+)
+
+const defaultMaxConcurrentLookups = 4
+
+type exchangeRateGetter interface {
+	GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error)
+}
+
+// convertToCurrencies will convert the purchase to each of the targets, looking up at most maxConcurrent exchange rates at a time.
+// Results are returned in the same order as the targets and each result carries its own success or failure.
+func convertToCurrencies(ctx context.Context, getter exchangeRateGetter, trans *ent.Transaction, targets []types.ConversionTarget, policy RateSelectionPolicy, rounding money.RoundingMode, maxConcurrent int) []types.CurrencyConversionResult {
+	results := make([]types.CurrencyConversionResult, len(targets))
+
+	// the rate of the original currency of the purchase is shared by the targets whose rates are recorded on the same date
+	lookups := newRateLookups(getter)
+
+	runConcurrently(len(targets), maxConcurrent, func(i int) {
+		results[i] = convertToCurrency(ctx, lookups, trans, targets[i], policy, rounding)
+	})
+
+	return results
+}
+
+// convertTransactions will convert each of the purchases to the target currency. The exchange rates of both the target and
+// the original currency of the purchases are looked up once per rate window, since purchases whose rate is selected from the
+// same window share the same rate, with at most maxConcurrent purchases converted at a time. Results are returned in the
+// same order as the transactions.
+func convertTransactions(ctx context.Context, getter exchangeRateGetter, transactions []*ent.Transaction, target types.ConversionTarget, policy RateSelectionPolicy, rounding money.RoundingMode, maxConcurrent int) []types.CurrencyConversionResult {
+	lookups := newRateLookups(getter)
+	results := make([]types.CurrencyConversionResult, len(transactions))
+
+	runConcurrently(len(transactions), maxConcurrent, func(i int) {
+		results[i] = convertToCurrency(ctx, lookups, transactions[i], target, policy, rounding)
+	})
+
+	slog.InfoContext(ctx, "converted purchase transactions", "transactions", len(transactions), "exchange_rate_lookups", lookups.count())
+
+	return results
+}
+
+// rateLookups dedupes the exchange rate lookups of a batch of conversions by the rate window they are keyed by in the cache,
+// e.g. the latest quarter-end on or before the purchase date for the default policy. The rate looked up for the window is only
+// shared with a purchase when the cache would serve it for that purchase as well, otherwise the rate is looked up for the
+// purchase day, which is deduped in turn.
+type rateLookups struct {
+	getter exchangeRateGetter
+
+	mu       sync.Mutex
+	byWindow map[string]*rateLookup
+	lookups  int
+}
+
+type rateLookup struct {
+	once    sync.Once
+	payload ExchangeRatePayload
+	rate    ExchangeRateResponse
+	err     error
+}
+
+func newRateLookups(getter exchangeRateGetter) *rateLookups {
+	return &rateLookups{getter: getter, byWindow: make(map[string]*rateLookup)}
+}
+
+func (l *rateLookups) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	windowKey, cacheable := getCacheKey(payload)
+	dayKey := windowKey + "|" + payload.RecordDate.UTC().Format(time.DateOnly)
+
+	shared := l.lookup(ctx, payload, windowKey, dayKey)
+	switch {
+	case shared.payload.RecordDate.Equal(payload.RecordDate):
+		return shared.rate, shared.err
+	case shared.err == nil && cacheable(shared.rate) && isWithinPolicyWindow(payload, shared.rate):
+		return shared.rate, nil
+	case shared.err != nil && !isRateNotFound(shared.err):
+		// the provider failing is not specific to the purchase date
+		return shared.rate, shared.err
+	}
+
+	own := l.lookup(ctx, payload, dayKey)
+
+	return own.rate, own.err
+}
+
+// lookup will return the lookup registered for the key, looking up the rate of the payload once when there is none. A new
+// lookup is registered under the aliases as well, unless they already have one.
+func (l *rateLookups) lookup(ctx context.Context, payload ExchangeRatePayload, key string, aliases ...string) *rateLookup {
+	l.mu.Lock()
+
+	found, ok := l.byWindow[key]
+	if !ok {
+		found = &rateLookup{payload: payload}
+		l.byWindow[key] = found
+		l.lookups++
+
+		for _, alias := range aliases {
+			if _, ok := l.byWindow[alias]; !ok {
+				l.byWindow[alias] = found
+			}
+		}
+	}
+
+	l.mu.Unlock()
+
+	found.once.Do(func() {
+		found.rate, found.err = l.getter.GetExchangeRate(ctx, found.payload)
+	})
+
+	return found
+}
+
+// count will return the number of distinct exchange rate lookups.
+func (l *rateLookups) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.lookups
+}
+
+// runConcurrently will call fn for every index in [0, n) with at most maxConcurrent calls running at a time and wait for all of them to finish.
+func runConcurrently(n int, maxConcurrent int, fn func(i int)) {
+	if maxConcurrent < 1 {
+		maxConcurrent = defaultMaxConcurrentLookups
+	}
+
+	sem := make(chan struct{}, maxConcurrent)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func convertToCurrency(ctx context.Context, getter exchangeRateGetter, trans *ent.Transaction, target types.ConversionTarget, policy RateSelectionPolicy, rounding money.RoundingMode) types.CurrencyConversionResult {
+	payload := ExchangeRatePayload{
+		CountryName: target.Country,
+		Currency:    target.Currency,
+		RecordDate:  trans.Date,
+		Policy:      policy,
+	}
+
+	er, err := getter.GetExchangeRate(ctx, payload)
+
+	return getConversionResult(ctx, getter, target, trans, payload, er, err, rounding)
+}
+
+// getConversionResult will convert the purchase using the looked up exchange rate, or report why the exchange rate lookup or conversion failed.
+func getConversionResult(ctx context.Context, getter exchangeRateGetter, target types.ConversionTarget, trans *ent.Transaction, payload ExchangeRatePayload, er ExchangeRateResponse, err error, rounding money.RoundingMode) types.CurrencyConversionResult {
+	result := types.CurrencyConversionResult{
+		Country:  target.Country,
+		Currency: target.Currency,
+	}
+
+	if err == nil {
+		var converted types.GetPurchaseTransaction
+
+		converted, err = convertCurrency(ctx, getter, payload, trans, er, rounding)
+		if err == nil {
+			result.Status = http.StatusOK
+			result.ConvertedDetails = &converted.ConvertedDetails
+
+			return result
+		}
+	}
+
+	if IsOutsideRateWindow(err) {
+		metrics.ConversionsOutsideRateWindow.Inc()
+	}
+
+	status, code, message := getConversionError(ctx, err)
+	errorCode := string(code)
+	result.Status = status
+	result.Error = &message
+	result.ErrorCode = &errorCode
+
+	return result
+}
+
+// getConversionError will return the HTTP status, error code and client facing message of the failed conversion.
+// Unexpected errors are logged and reported as internal server error without leaking details to the client.
+func getConversionError(ctx context.Context, err error) (int, apiout.ErrorCode, string) {
+	var aerr *apiout.APIError
+
+	switch {
+	case apiout.IsBadRequest(err):
+		return http.StatusBadRequest, apiout.CodeBadRequest, err.Error()
+	case errors.As(err, &aerr):
+		return aerr.GetHttpStatus(), aerr.GetCode(), err.Error()
+	default:
+		slog.ErrorContext(ctx, "unable to convert purchase", "err", err)
+
+		return http.StatusInternalServerError, apiout.CodeInternal, http.StatusText(http.StatusInternalServerError)
+	}
+}
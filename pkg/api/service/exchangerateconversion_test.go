@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/metrics"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+)
+
+type fakeExchangeRateGetter struct {
+	mu      sync.Mutex
+	calls   []ExchangeRatePayload
+	rates   map[string]ExchangeRateResponse
+	errs    map[string]error
+	active  atomic.Int32
+	maxSeen atomic.Int32
+}
+
+func (f *fakeExchangeRateGetter) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	active := f.active.Add(1)
+	defer f.active.Add(-1)
+
+	for {
+		seen := f.maxSeen.Load()
+		if active <= seen || f.maxSeen.CompareAndSwap(seen, active) {
+			break
+		}
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, payload)
+
+	if err, ok := f.errs[payload.Currency]; ok {
+		return ExchangeRateResponse{}, err
+	}
+
+	return f.rates[payload.Currency], nil
+}
+
+func TestConvertToCurrencies(t *testing.T) {
+	trans := &ent.Transaction{
+		Date:        time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC),
+		AmountInUsd: decimal.NewFromInt(10),
+	}
+
+	getter := &fakeExchangeRateGetter{
+		rates: map[string]ExchangeRateResponse{
+			"Rupee": {CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2023-09-30"},
+			"Euro":  {CountryCurrencyDesc: "Germany-Euro", ExchangeRate: "0.9", RecordDate: "2023-09-30"},
+		},
+		errs: map[string]error{
+			"Dinar": errRateNotFound,
+			"Peso":  errors.New("connection refused"),
+			"Yen":   apiout.NewCodedError(apiout.CodeExchangeRateServiceUnavailable, errors.New("rate limited")),
+		},
+	}
+
+	targets := []types.ConversionTarget{
+		{Country: "Nepal", Currency: "Rupee"},
+		{Country: "Iraq", Currency: "Dinar"},
+		{Country: "Germany", Currency: "Euro"},
+		{Country: "Mexico", Currency: "Peso"},
+		{Country: "Japan", Currency: "Yen"},
+	}
+
+	outsideRateWindow := testutil.ToFloat64(metrics.ConversionsOutsideRateWindow)
+
+	got := convertToCurrencies(context.TODO(), getter, trans, targets, RateSelectionPolicy{}, money.HalfUp, 2)
+
+	assert.Equal(t, len(targets), len(got))
+	assert.Assert(t, getter.maxSeen.Load() <= 2)
+	assert.Equal(t, outsideRateWindow+1, testutil.ToFloat64(metrics.ConversionsOutsideRateWindow))
+
+	tests := []struct {
+		name       string
+		wantStatus int
+		wantAmount string
+		wantErr    string
+		wantCode   apiout.ErrorCode
+	}{
+		{name: "Rupee", wantStatus: http.StatusOK, wantAmount: "1305"},
+		{name: "Dinar", wantStatus: http.StatusBadRequest, wantErr: errRateNotFound.Error(), wantCode: apiout.CodeExchangeRateNotFound},
+		{name: "Euro", wantStatus: http.StatusOK, wantAmount: "9"},
+		{name: "Peso", wantStatus: http.StatusInternalServerError, wantErr: http.StatusText(http.StatusInternalServerError), wantCode: apiout.CodeInternal},
+		{name: "Yen", wantStatus: http.StatusServiceUnavailable, wantErr: "rate limited", wantCode: apiout.CodeExchangeRateServiceUnavailable},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, targets[i].Currency, got[i].Currency)
+			assert.Equal(t, tt.wantStatus, got[i].Status)
+
+			if tt.wantErr != "" {
+				assert.Assert(t, got[i].ConvertedDetails == nil)
+				assert.Equal(t, tt.wantErr, *got[i].Error)
+				assert.Equal(t, string(tt.wantCode), *got[i].ErrorCode)
+				return
+			}
+
+			assert.Assert(t, got[i].Error == nil)
+			assert.Equal(t, tt.wantAmount, got[i].ConvertedDetails.Amount)
+		})
+	}
+}
+
+func TestConvertTransactions(t *testing.T) {
+	day := time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)
+	transactions := []*ent.Transaction{
+		{Date: day.Add(2 * time.Hour), AmountInUsd: decimal.NewFromInt(10)},
+		{Date: day.AddDate(0, 0, -1), AmountInUsd: decimal.NewFromInt(20)},
+		{Date: day.Add(20 * time.Hour), AmountInUsd: decimal.NewFromInt(30)},
+		{Date: day.AddDate(0, 0, -1).Add(time.Hour), AmountInUsd: decimal.NewFromInt(40)},
+	}
+	quarter := []*ent.Transaction{
+		{Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), AmountInUsd: decimal.NewFromInt(10)},
+		{Date: time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC), AmountInUsd: decimal.NewFromInt(20)},
+		{Date: time.Date(2023, 12, 30, 0, 0, 0, 0, time.UTC), AmountInUsd: decimal.NewFromInt(30)},
+		{Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), AmountInUsd: decimal.NewFromInt(40)},
+	}
+	target := types.ConversionTarget{Country: "Nepal", Currency: "Rupee"}
+
+	tests := []struct {
+		name         string
+		transactions []*ent.Transaction
+		policy       RateSelectionPolicy
+		getter       *fakeExchangeRateGetter
+		wantLookups  int
+		wantStatus   []int
+		wantAmounts  []string
+	}{
+		{
+			name:         "should look up the rate once per day for nearest policy",
+			transactions: transactions,
+			policy:       RateSelectionPolicy{Mode: Nearest},
+			getter: &fakeExchangeRateGetter{
+				rates: map[string]ExchangeRateResponse{"Rupee": {CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "2", RecordDate: "2023-09-29"}},
+			},
+			wantLookups: 2,
+			wantStatus:  []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusOK},
+			wantAmounts: []string{"20", "40", "60", "80"},
+		},
+		{
+			name:         "should report error for every transaction when rate cannot be found",
+			transactions: transactions,
+			policy:       RateSelectionPolicy{Mode: Nearest},
+			getter: &fakeExchangeRateGetter{
+				errs: map[string]error{"Rupee": errRateNotFound},
+			},
+			wantLookups: 2,
+			wantStatus:  []int{http.StatusBadRequest, http.StatusBadRequest, http.StatusBadRequest, http.StatusBadRequest},
+		},
+		{
+			name:         "should look up the quarter-end rate once for every purchase of the quarter",
+			transactions: quarter,
+			getter: &fakeExchangeRateGetter{
+				rates: map[string]ExchangeRateResponse{"Rupee": {CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "2", RecordDate: "2023-09-30"}},
+			},
+			wantLookups: 1,
+			wantStatus:  []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusOK},
+			wantAmounts: []string{"20", "40", "60", "80"},
+		},
+		{
+			name:         "should look up the rate per day when the rate of the window is recorded mid-quarter",
+			transactions: quarter,
+			getter: &fakeExchangeRateGetter{
+				rates: map[string]ExchangeRateResponse{"Rupee": {CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "2", RecordDate: "2023-10-01"}},
+			},
+			wantLookups: 3,
+			wantStatus:  []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusOK},
+			wantAmounts: []string{"20", "40", "60", "80"},
+		},
+		{
+			name:         "should share the failure of the provider within the window",
+			transactions: quarter,
+			getter: &fakeExchangeRateGetter{
+				errs: map[string]error{"Rupee": apiout.NewRequestError(errors.New("rate limited"), http.StatusTooManyRequests)},
+			},
+			wantLookups: 1,
+			wantStatus:  []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertTransactions(context.TODO(), tt.getter, tt.transactions, target, tt.policy, money.HalfUp, 4)
+
+			assert.Equal(t, tt.wantLookups, len(tt.getter.calls))
+			assert.Equal(t, tt.policy, tt.getter.calls[0].Policy)
+			assert.Equal(t, len(tt.transactions), len(got))
+
+			for i, result := range got {
+				assert.Equal(t, tt.wantStatus[i], result.Status)
+
+				if tt.wantAmounts == nil {
+					assert.Assert(t, result.Error != nil)
+					continue
+				}
+
+				assert.Equal(t, tt.wantAmounts[i], result.ConvertedDetails.Amount)
+			}
+		})
+	}
+}
+
+func TestConvertTransactionsThroughUSD(t *testing.T) {
+	purchaseDate := time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC)
+	transactions := []*ent.Transaction{
+		foreignTransaction(purchaseDate, "1000", "EUR", "1089.32"),
+		foreignTransaction(purchaseDate.AddDate(0, 0, 10), "2000", "EUR", "2178.65"),
+		foreignTransaction(purchaseDate.AddDate(0, 0, 20), "3000", "EUR", "3267.97"),
+	}
+
+	getter := &fakeExchangeRateGetter{
+		rates: map[string]ExchangeRateResponse{
+			"Rupee": {CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "133.2", RecordDate: "2023-09-30"},
+			"Euro":  {CountryCurrencyDesc: "Euro Zone-Euro", ExchangeRate: "0.918", RecordDate: "2023-09-30"},
+		},
+	}
+
+	got := convertTransactions(context.TODO(), getter, transactions, types.ConversionTarget{Country: "Nepal", Currency: "Rupee"}, RateSelectionPolicy{}, money.HalfUp, 2)
+
+	// both the target and the source leg are looked up once for the quarter
+	assert.Equal(t, 2, len(getter.calls))
+
+	for i, want := range []string{"145098.04", "290196.08", "435294.12"} {
+		assert.Equal(t, http.StatusOK, got[i].Status)
+		assert.Equal(t, want, got[i].ConvertedDetails.Amount)
+	}
+}
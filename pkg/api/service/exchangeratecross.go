@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/currency"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// sourceLeg is the exchange rate of the currency a purchase was made in to USD, which the purchase is converted through when
+// converting it to another currency.
+type sourceLeg struct {
+	amount money.Money
+	rate   ExchangeRateResponse
+}
+
+// getSourceLeg will return the exchange rate of the currency the purchase was made in, recorded on the same date as the
+// exchange rate of the target currency such that the cross rate never mixes two record dates. The rate captured when the
+// purchase was stored is reused when it was recorded on that date, otherwise the rate is looked up for the record date of
+// the target rate. Purchases made in USD have no source leg and nil is returned.
+func getSourceLeg(ctx context.Context, getter exchangeRateGetter, trans *ent.Transaction, target ExchangeRateResponse) (*sourceLeg, error) {
+	if trans.OriginalAmount == nil || trans.OriginalCurrency == nil || strings.EqualFold(*trans.OriginalCurrency, usdCurrencyCode) {
+		return nil, nil
+	}
+
+	d, err := currency.Resolve("", *trans.OriginalCurrency)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "unable to resolve original currency of transaction '%s'", trans.ID)
+	}
+
+	amount := money.New(*trans.OriginalAmount, d.CurrencyCode)
+
+	if trans.ExchangeRate != nil && trans.ExchangeRateDate != nil && trans.ExchangeRateDate.Format(time.DateOnly) == target.RecordDate {
+		return &sourceLeg{amount: amount, rate: ExchangeRateResponse{
+			CountryCurrencyDesc: d.Country + "-" + d.Currency,
+			ExchangeRate:        trans.ExchangeRate.String(),
+			RecordDate:          target.RecordDate,
+		}}, nil
+	}
+
+	recordDate, err := time.Parse(time.DateOnly, target.RecordDate)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "invalid record date '%s' of target exchange rate", target.RecordDate)
+	}
+
+	// the latest rate on or before the record date of the target is the rate recorded on that date, when there is one
+	er, err := getter.GetExchangeRate(ctx, ExchangeRatePayload{
+		CountryName: d.Country,
+		Currency:    d.Currency,
+		RecordDate:  recordDate,
+		Policy:      RateSelectionPolicy{Mode: LatestOnOrBefore, LookbackMonths: target.Policy.LookbackMonths},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if er.RecordDate != target.RecordDate {
+		return nil, apiout.NewCodedError(apiout.CodeExchangeRateNotFound, errors.Errorf(
+			"the purchase cannot be converted to the target currency, no exchange rate of %s was recorded on %s, the record date of the target exchange rate",
+			d.CurrencyCode, target.RecordDate))
+	}
+
+	return &sourceLeg{amount: amount, rate: er}, nil
+}
+
+// triangulateAmount will convert the amount from the source currency to the target currency through USD, given the rates of
+// both currencies to USD. The amount is multiplied by the target rate before it is divided by the source rate, thus the
+// intermediate USD amount is never rounded and the only inexact step is the single division, which keeps 16 decimal places.
+// Rounding the result to the minor units of the target currency is left to the caller.
+func triangulateAmount(amount decimal.Decimal, sourceRate decimal.Decimal, targetRate decimal.Decimal) (decimal.Decimal, error) {
+	if !sourceRate.IsPositive() {
+		return decimal.Decimal{}, errors.Errorf("invalid source exchange rate '%s'", sourceRate)
+	}
+
+	return amount.Mul(targetRate).Div(sourceRate), nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+)
+
+func TestTriangulateAmount(t *testing.T) {
+	tests := []struct {
+		name       string
+		amount     string
+		sourceRate string
+		targetRate string
+		want       string
+		wantErr    string
+	}{
+		{name: "should convert through USD", amount: "1000", sourceRate: "0.918", targetRate: "133.2", want: "145098.0392156862745098"},
+		{name: "should give back the amount for the same currency", amount: "1000", sourceRate: "0.918", targetRate: "0.918", want: "1000"},
+		{name: "should fail for zero source rate", amount: "1000", sourceRate: "0", targetRate: "133.2", wantErr: "invalid source exchange rate '0'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := triangulateAmount(decimal.RequireFromString(tt.amount), decimal.RequireFromString(tt.sourceRate), decimal.RequireFromString(tt.targetRate))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestConvertCurrencyThroughUSD(t *testing.T) {
+	purchaseDate := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+	policy := RateSelectionPolicy{Mode: Nearest, LookbackMonths: 3}
+	rupee := ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "133.2", RecordDate: "2023-12-31", Policy: policy}
+
+	tests := []struct {
+		name             string
+		trans            *ent.Transaction
+		rates            map[string]ExchangeRateResponse
+		errs             map[string]error
+		want             string
+		wantSourceAmount string
+		wantSourceRate   string
+		wantLookup       bool
+		wantErr          string
+	}{
+		{
+			name:             "should convert original amount instead of the rounded amount in USD",
+			trans:            foreignTransaction(purchaseDate, "1000", "EUR", "1089.32"),
+			rates:            map[string]ExchangeRateResponse{"Euro": {CountryCurrencyDesc: "Euro Zone-Euro", ExchangeRate: "0.918", RecordDate: "2023-12-31"}},
+			want:             "145098.04",
+			wantSourceAmount: "1000",
+			wantSourceRate:   "0.918",
+			wantLookup:       true,
+		},
+		{
+			name:             "should reuse the rate captured with the purchase when recorded on the target record date",
+			trans:            capturedTransaction(foreignTransaction(purchaseDate, "1000", "EUR", "1089.32"), "0.918", "2023-12-31"),
+			want:             "145098.04",
+			wantSourceAmount: "1000",
+			wantSourceRate:   "0.918",
+		},
+		{
+			name:             "should look up the source rate when the captured rate was recorded on another date",
+			trans:            capturedTransaction(foreignTransaction(purchaseDate, "1000", "EUR", "1089.32"), "0.95", "2023-09-30"),
+			rates:            map[string]ExchangeRateResponse{"Euro": {CountryCurrencyDesc: "Euro Zone-Euro", ExchangeRate: "0.918", RecordDate: "2023-12-31"}},
+			want:             "145098.04",
+			wantSourceAmount: "1000",
+			wantSourceRate:   "0.918",
+			wantLookup:       true,
+		},
+		{
+			name:    "should fail when the source rate is recorded on another date than the target rate",
+			trans:   foreignTransaction(purchaseDate, "1000", "EUR", "1089.32"),
+			rates:   map[string]ExchangeRateResponse{"Euro": {CountryCurrencyDesc: "Euro Zone-Euro", ExchangeRate: "0.918", RecordDate: "2023-09-30"}},
+			wantErr: "no exchange rate of EUR was recorded on 2023-12-31",
+		},
+		{
+			name:  "should convert purchase made in USD from the amount in USD",
+			trans: &ent.Transaction{Date: purchaseDate, AmountInUsd: decimal.RequireFromString("1089.32")},
+			want:  "145097.42",
+		},
+		{
+			name:    "should fail when the exchange rate of the original currency is not found",
+			trans:   foreignTransaction(purchaseDate, "1000", "EUR", "1089.32"),
+			errs:    map[string]error{"Euro": errRateNotFound},
+			wantErr: errRateNotFound.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getter := &fakeExchangeRateGetter{rates: tt.rates, errs: tt.errs}
+
+			got, err := convertCurrency(context.TODO(), getter, ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"}, tt.trans, rupee, money.HalfUp)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got.ConvertedDetails.Amount)
+			assert.Equal(t, "133.2", got.ConvertedDetails.ExchangeRateUsed)
+			assert.Equal(t, "2023-12-31", got.ConvertedDetails.ExchangeRateDate)
+
+			if tt.wantSourceAmount == "" {
+				assert.Equal(t, 0, len(getter.calls))
+				assert.Assert(t, got.ConvertedDetails.SourceAmount == nil)
+				assert.Assert(t, got.ConvertedDetails.SourceExchangeRateUsed == nil)
+				return
+			}
+
+			if tt.wantLookup {
+				// the source leg is looked up for the record date of the target rate
+				assert.Equal(t, 1, len(getter.calls))
+				assert.Equal(t, time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), getter.calls[0].RecordDate)
+				assert.Equal(t, RateSelectionPolicy{Mode: LatestOnOrBefore, LookbackMonths: policy.LookbackMonths}, getter.calls[0].Policy)
+			} else {
+				assert.Equal(t, 0, len(getter.calls))
+			}
+
+			assert.Equal(t, tt.wantSourceAmount, *got.ConvertedDetails.SourceAmount)
+			assert.Equal(t, "EUR", *got.ConvertedDetails.SourceCurrency)
+			assert.Equal(t, tt.wantSourceRate, *got.ConvertedDetails.SourceExchangeRateUsed)
+			assert.Equal(t, "2023-12-31", *got.ConvertedDetails.SourceExchangeRateDate)
+		})
+	}
+}
+
+func foreignTransaction(date time.Time, originalAmount, originalCurrency, amountInUSD string) *ent.Transaction {
+	return &ent.Transaction{
+		Date:             date,
+		AmountInUsd:      decimal.RequireFromString(amountInUSD),
+		OriginalAmount:   ptr(decimal.RequireFromString(originalAmount)),
+		OriginalCurrency: ptr(originalCurrency),
+	}
+}
+
+func capturedTransaction(trans *ent.Transaction, exchangeRate string, exchangeRateDate string) *ent.Transaction {
+	trans.ExchangeRate = ptr(decimal.RequireFromString(exchangeRate))
+	recordDate, err := time.Parse(time.DateOnly, exchangeRateDate)
+	if err != nil {
+		panic(err)
+	}
+
+	trans.ExchangeRateDate = &recordDate
+
+	return trans
+}
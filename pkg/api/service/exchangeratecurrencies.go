@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/eddie023/wex-tag/pkg/currency"
+	"github.com/eddie023/wex-tag/pkg/tracing"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CurrencySummary describes the rates a provider holds for a single country and currency pair.
+type CurrencySummary struct {
+	Country             string
+	Currency            string
+	CountryCurrencyDesc string
+	FirstRecordDate     time.Time
+	LastRecordDate      time.Time
+	// LatestRate is the rate of LastRecordDate as the amount of currency for 1 USD
+	LatestRate string
+}
+
+// CurrencyLister is implemented by the exchange rate providers which are able to list the country and currency pairs they hold rates for.
+type CurrencyLister interface {
+	ListCurrencies(ctx context.Context) ([]CurrencySummary, error)
+}
+
+// ListCurrencies will return the country and currency pairs of every provider which is able to list them, ordered by
+// country and currency. A pair held by several providers is reported by the first of them, the same way as the lookups.
+// Only the pairs whose country starts with countryPrefix are returned, matched case insensitively.
+func (c *ExchangeRateProviderChain) ListCurrencies(ctx context.Context, countryPrefix string) ([]types.SupportedCurrency, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "ExchangeRateProviderChain.ListCurrencies", trace.WithAttributes(
+		attribute.String("currency.country_prefix", countryPrefix),
+	))
+	defer span.End()
+
+	countryPrefix = strings.ToLower(strings.TrimSpace(countryPrefix))
+	seen := make(map[string]bool)
+	out := []types.SupportedCurrency{}
+
+	var (
+		err    error
+		listed bool
+	)
+
+	for _, provider := range c.Providers {
+		lister, ok := provider.(CurrencyLister)
+		if !ok {
+			continue
+		}
+
+		var summaries []CurrencySummary
+
+		summaries, err = lister.ListCurrencies(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "exchange rate provider did not list its currencies", "provider", provider.Name(), "err", err)
+			continue
+		}
+
+		listed = true
+
+		for _, s := range summaries {
+			if seen[s.CountryCurrencyDesc] || !strings.HasPrefix(strings.ToLower(s.Country), countryPrefix) {
+				continue
+			}
+
+			seen[s.CountryCurrencyDesc] = true
+			out = append(out, getSupportedCurrency(s, provider.Name()))
+		}
+	}
+
+	// currencies are only unavailable when none of the providers could list them
+	if !listed && err != nil {
+		tracing.RecordError(span, err)
+
+		return nil, err
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Country != out[j].Country {
+			return out[i].Country < out[j].Country
+		}
+
+		return out[i].Currency < out[j].Currency
+	})
+
+	span.SetAttributes(attribute.Int("currency.count", len(out)))
+
+	return out, nil
+}
+
+func getSupportedCurrency(s CurrencySummary, provider string) types.SupportedCurrency {
+	out := types.SupportedCurrency{
+		Country:             s.Country,
+		Currency:            s.Currency,
+		CountryCurrencyDesc: s.CountryCurrencyDesc,
+		FirstRecordDate:     s.FirstRecordDate.Format(time.DateOnly),
+		LastRecordDate:      s.LastRecordDate.Format(time.DateOnly),
+		LatestRate:          s.LatestRate,
+		Provider:            provider,
+	}
+
+	if d, ok := currency.Lookup(s.Country, s.Currency); ok {
+		out.CountryCode = &d.CountryCode
+		out.CurrencyCode = &d.CurrencyCode
+	}
+
+	return out
+}
+
+// ListCurrencies will summarise the rates stored in the local exchange_rates table, which holds every rate synced from
+// or previously fetched through the treasury API.
+func (s *ExchangeRateStore) ListCurrencies(ctx context.Context) ([]CurrencySummary, error) {
+	rates, err := s.Ent.ExchangeRate.Query().
+		Select(exchangerate.FieldCountry, exchangerate.FieldCurrency, exchangerate.FieldCountryCurrencyDesc, exchangerate.FieldRate, exchangerate.FieldRecordDate).
+		Order(exchangerate.ByCountryCurrencyDesc(), exchangerate.ByRecordDate(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []CurrencySummary{}
+
+	for _, rate := range rates {
+		n := len(out)
+		if n == 0 || out[n-1].CountryCurrencyDesc != rate.CountryCurrencyDesc {
+			out = append(out, CurrencySummary{
+				Country:             rate.Country,
+				Currency:            rate.Currency,
+				CountryCurrencyDesc: rate.CountryCurrencyDesc,
+				FirstRecordDate:     rate.RecordDate,
+			})
+			n++
+		}
+
+		// rates are ordered by record date, thus the last one of the pair is the latest
+		out[n-1].LastRecordDate = rate.RecordDate
+		out[n-1].LatestRate = rate.Rate.String()
+	}
+
+	return out, nil
+}
+
+// ListCurrencies will list the currencies of the wrapped provider, which are not cached.
+func (c *ExchangeRateCache) ListCurrencies(ctx context.Context) ([]CurrencySummary, error) {
+	lister, ok := c.Provider.(CurrencyLister)
+	if !ok {
+		return nil, nil
+	}
+
+	return lister.ListCurrencies(ctx)
+}
+
+// ListCurrencies will summarise the rates loaded from the file.
+func (f *FileExchangeRateProvider) ListCurrencies(ctx context.Context) ([]CurrencySummary, error) {
+	out := make([]CurrencySummary, 0, len(f.rates))
+
+	for countryCurrencyDesc, rates := range f.rates {
+		if len(rates) == 0 {
+			continue
+		}
+
+		// rates are sorted by record date in descending order
+		latest := rates[0]
+
+		country, currencyName := latest.rate.Country, latest.rate.Currency
+		if country == "" || currencyName == "" {
+			country, currencyName = splitCountryCurrencyDesc(countryCurrencyDesc)
+		}
+
+		out = append(out, CurrencySummary{
+			Country:             country,
+			Currency:            currencyName,
+			CountryCurrencyDesc: countryCurrencyDesc,
+			FirstRecordDate:     rates[len(rates)-1].recordDate,
+			LastRecordDate:      latest.recordDate,
+			LatestRate:          latest.rate.ExchangeRate,
+		})
+	}
+
+	return out, nil
+}
+
+// ListCurrencies will summarise the reference rates of every currency which can be cross rated to USD.
+func (e *ECBExchangeRateProvider) ListCurrencies(ctx context.Context) ([]CurrencySummary, error) {
+	days, err := e.getDays(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]CurrencySummary, 0, len(ecbCurrencyCodes))
+
+	for countryCurrencyDesc, code := range ecbCurrencyCodes {
+		// days are sorted by date in descending order, thus the first day with a rate is the latest one
+		latest := slices.IndexFunc(days, func(day ecbDay) bool {
+			_, ok := crossRateToUSD(day.rates, code)
+			return ok
+		})
+		if latest < 0 {
+			continue
+		}
+
+		first := len(days) - 1
+		for ; first > latest; first-- {
+			if _, ok := crossRateToUSD(days[first].rates, code); ok {
+				break
+			}
+		}
+
+		rate, _ := crossRateToUSD(days[latest].rates, code)
+		country, currencyName := splitCountryCurrencyDesc(countryCurrencyDesc)
+
+		out = append(out, CurrencySummary{
+			Country:             country,
+			Currency:            currencyName,
+			CountryCurrencyDesc: countryCurrencyDesc,
+			FirstRecordDate:     days[first].date,
+			LastRecordDate:      days[latest].date,
+			LatestRate:          rate.String(),
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/db"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"gotest.tools/assert"
+)
+
+type fakeCurrencyLister struct {
+	fakeExchangeRateProvider
+
+	currencies []CurrencySummary
+	listErr    error
+}
+
+func (f *fakeCurrencyLister) ListCurrencies(ctx context.Context) ([]CurrencySummary, error) {
+	return f.currencies, f.listErr
+}
+
+func TestExchangeRateProviderChainListCurrencies(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return d
+	}
+
+	nepal := CurrencySummary{Country: "Nepal", Currency: "Rupee", CountryCurrencyDesc: "Nepal-Rupee", FirstRecordDate: date("2022-06-30"), LastRecordDate: date("2022-12-31"), LatestRate: "132.5"}
+	netherlands := CurrencySummary{Country: "Netherlands", Currency: "Guilder", CountryCurrencyDesc: "Netherlands-Guilder", FirstRecordDate: date("2001-03-31"), LastRecordDate: date("2001-12-31"), LatestRate: "2.5"}
+	uk := CurrencySummary{Country: "United Kingdom", Currency: "Pound", CountryCurrencyDesc: "United Kingdom-Pound", FirstRecordDate: date("2023-12-01"), LastRecordDate: date("2023-12-01"), LatestRate: "0.79"}
+
+	first := &fakeCurrencyLister{fakeExchangeRateProvider: fakeExchangeRateProvider{name: "first"}, currencies: []CurrencySummary{uk, nepal}}
+	second := &fakeCurrencyLister{fakeExchangeRateProvider: fakeExchangeRateProvider{name: "second"}, currencies: []CurrencySummary{nepal, netherlands}}
+	failing := &fakeCurrencyLister{fakeExchangeRateProvider: fakeExchangeRateProvider{name: "failing"}, listErr: errors.New("service unavailable")}
+	unlisted := &fakeExchangeRateProvider{name: "unlisted"}
+
+	tests := []struct {
+		name          string
+		providers     []ExchangeRateProvider
+		countryPrefix string
+
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:      "should list currencies of the first provider holding them ordered by country",
+			providers: []ExchangeRateProvider{unlisted, first, failing, second},
+			want:      []string{"Nepal-Rupee first", "Netherlands-Guilder second", "United Kingdom-Pound first"},
+		},
+		{
+			name:          "should filter by country prefix case insensitively",
+			providers:     []ExchangeRateProvider{first, second},
+			countryPrefix: "ne",
+			want:          []string{"Nepal-Rupee first", "Netherlands-Guilder second"},
+		},
+		{
+			name:      "should return empty list when no provider is able to list currencies",
+			providers: []ExchangeRateProvider{unlisted},
+			want:      []string{},
+		},
+		{
+			name:      "should fail when every provider failed to list currencies",
+			providers: []ExchangeRateProvider{failing},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &ExchangeRateProviderChain{Providers: tt.providers}
+
+			got, err := chain.ListCurrencies(context.TODO(), tt.countryPrefix)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+
+			assert.NilError(t, err)
+
+			descs := []string{}
+			for _, c := range got {
+				descs = append(descs, c.CountryCurrencyDesc+" "+c.Provider)
+			}
+
+			assert.DeepEqual(t, tt.want, descs)
+		})
+	}
+
+	got, err := (&ExchangeRateProviderChain{Providers: []ExchangeRateProvider{first}}).ListCurrencies(context.TODO(), "united")
+	assert.NilError(t, err)
+
+	gbp, gb := "GBP", "GB"
+	assert.DeepEqual(t, []types.SupportedCurrency{{
+		Country:             "United Kingdom",
+		Currency:            "Pound",
+		CountryCurrencyDesc: "United Kingdom-Pound",
+		CountryCode:         &gb,
+		CurrencyCode:        &gbp,
+		FirstRecordDate:     "2023-12-01",
+		LastRecordDate:      "2023-12-01",
+		LatestRate:          "0.79",
+		Provider:            "first",
+	}}, got)
+}
+
+func TestExchangeRateStoreListCurrencies(t *testing.T) {
+	client := db.CreateTestDatabase(t)
+	defer client.Close()
+
+	s := ExchangeRateStore{Ent: client}
+
+	err := s.SaveExchangeRates(context.TODO(), []ExchangeRateResponse{
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"},
+		{CountryCurrencyDesc: "United Kingdom-Pound", ExchangeRate: "0.82", RecordDate: "2022-09-30"},
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "132.5", RecordDate: "2022-12-31"},
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "128.5", RecordDate: "2022-06-30"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.ListCurrencies(context.TODO())
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []string{"Nepal-Rupee 2022-06-30 2022-12-31 132.5", "United Kingdom-Pound 2022-09-30 2022-09-30 0.82"}, summarise(got))
+	assert.Equal(t, "United Kingdom", got[1].Country)
+	assert.Equal(t, "Pound", got[1].Currency)
+}
+
+func TestFileExchangeRateProviderListCurrencies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.csv")
+	err := os.WriteFile(path, []byte("record_date,country_currency_desc,exchange_rate\n2022-06-30,Nepal-Rupee,128.5\n2022-12-31,Nepal-Rupee,132.5\n2022-09-30,Nepal-Rupee,130.5\n2022-09-30,Guinea-Bissau-Cfa Franc,640.0\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := NewFileExchangeRateProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := provider.ListCurrencies(context.TODO())
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []string{"Guinea-Bissau-Cfa Franc 2022-09-30 2022-09-30 640.0", "Nepal-Rupee 2022-06-30 2022-12-31 132.5"}, summarise(got))
+}
+
+func TestECBExchangeRateProviderListCurrencies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eurofxref-hist.xml")
+	err := os.WriteFile(path, []byte(testECBReferenceRates), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &ECBExchangeRateProvider{Source: path}
+
+	got, err := provider.ListCurrencies(context.TODO())
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []string{
+		"Euro Zone-Euro 2023-11-30 2023-12-01 0.919879",
+		"Japan-Yen 2023-11-30 2023-12-01 147.373747",
+		"United Kingdom-Pound 2023-12-01 2023-12-01 0.790728",
+	}, summarise(got))
+}
+
+// summarise will format the summaries as 'desc first last rate', sorted by desc.
+func summarise(summaries []CurrencySummary) []string {
+	out := make([]string, 0, len(summaries))
+	for _, s := range summaries {
+		out = append(out, s.CountryCurrencyDesc+" "+s.FirstRecordDate.Format(time.DateOnly)+" "+s.LastRecordDate.Format(time.DateOnly)+" "+s.LatestRate)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
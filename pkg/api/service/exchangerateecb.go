@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	ECB_REFERENCE_RATES_URL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml"
+
+	defaultECBRefreshInterval = 12 * time.Hour
+	// eurofxref-hist.xml is a few MB, thus loading it is given more time than a single treasury API call
+	defaultECBLoadTimeout = 30 * time.Second
+
+	// number of decimal places kept for the cross rates calculated from the euro reference rates
+	ecbCrossRatePrecision = 6
+)
+
+// ecbCurrencyCodes maps the treasury 'country_currency_desc' to ISO 4217 currency codes published by the ECB.
+var ecbCurrencyCodes = map[string]string{
+	"Australia-Dollar":      "AUD",
+	"Brazil-Real":           "BRL",
+	"Bulgaria-Lev":          "BGN",
+	"Canada-Dollar":         "CAD",
+	"China-Renminbi":        "CNY",
+	"Czech Republic-Koruna": "CZK",
+	"Denmark-Krone":         "DKK",
+	"Euro Zone-Euro":        "EUR",
+	"Hong Kong-Dollar":      "HKD",
+	"Hungary-Forint":        "HUF",
+	"Iceland-Krona":         "ISK",
+	"India-Rupee":           "INR",
+	"Indonesia-Rupiah":      "IDR",
+	"Israel-Shekel":         "ILS",
+	"Japan-Yen":             "JPY",
+	"Korea-Won":             "KRW",
+	"Malaysia-Ringgit":      "MYR",
+	"Mexico-Peso":           "MXN",
+	"New Zealand-Dollar":    "NZD",
+	"Norway-Krone":          "NOK",
+	"Philippines-Peso":      "PHP",
+	"Poland-Zloty":          "PLN",
+	"Romania-New Leu":       "RON",
+	"Singapore-Dollar":      "SGD",
+	"South Africa-Rand":     "ZAR",
+	"Sweden-Krona":          "SEK",
+	"Switzerland-Franc":     "CHF",
+	"Thailand-Baht":         "THB",
+	"Turkey-New Lira":       "TRY",
+	"United Kingdom-Pound":  "GBP",
+}
+
+// ECBExchangeRateProvider serves exchange rates from the European Central Bank euro foreign exchange reference rates.
+// ECB quotes every currency against EUR, thus the rates are cross rated through EUR to get the amount of currency for 1 USD.
+type ECBExchangeRateProvider struct {
+	// Source is either the URL or a local file path of ECB reference rates XML document such as eurofxref-hist.xml
+	Source string
+	// RefreshInterval is how long the loaded reference rates are used before being loaded again from the Source
+	RefreshInterval time.Duration
+	// LoadTimeout bounds loading the reference rates from the Source, regardless of the request which triggered the load
+	LoadTimeout time.Duration
+
+	// group ensures the reference rates are loaded by a single call at a time, which every lookup waiting for them shares
+	group singleflight.Group
+
+	mu       sync.Mutex
+	days     []ecbDay
+	loadedAt time.Time
+}
+
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+type ecbDay struct {
+	date time.Time
+	// euro reference rates keyed by ISO 4217 currency code
+	rates map[string]decimal.Decimal
+}
+
+// Name of the exchange rate provider.
+func (e *ECBExchangeRateProvider) Name() string {
+	return ECBProvider
+}
+
+// GetExchangeRate will return the cross rate of the reference rates the policy selects for the purchase date.
+func (e *ECBExchangeRateProvider) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	countryCurrencyDesc := getCountryCurrencyDesc(payload)
+
+	code, ok := ecbCurrencyCodes[countryCurrencyDesc]
+	if !ok {
+		return ExchangeRateResponse{}, payload.Policy.NotFoundError()
+	}
+
+	days, err := e.getDays(ctx)
+	if err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	var recordDates []time.Time
+	var rates []decimal.Decimal
+
+	for _, day := range days {
+		if !payload.Policy.InWindow(payload.RecordDate, day.date) {
+			continue
+		}
+
+		rate, ok := crossRateToUSD(day.rates, code)
+		if !ok {
+			continue
+		}
+
+		recordDates = append(recordDates, day.date)
+		rates = append(rates, rate)
+	}
+
+	selected := payload.Policy.Select(payload.RecordDate, recordDates)
+	if selected < 0 {
+		return ExchangeRateResponse{}, payload.Policy.NotFoundError()
+	}
+
+	return ExchangeRateResponse{
+		CountryCurrencyDesc: countryCurrencyDesc,
+		ExchangeRate:        rates[selected].String(),
+		RecordDate:          recordDates[selected].Format(time.DateOnly),
+	}, nil
+}
+
+// crossRateToUSD will convert the euro reference rate of given currency to the amount of currency for 1 USD.
+func crossRateToUSD(rates map[string]decimal.Decimal, code string) (decimal.Decimal, bool) {
+	usd, ok := rates["USD"]
+	if !ok || usd.IsZero() {
+		return decimal.Decimal{}, false
+	}
+
+	// ECB does not publish a rate for EUR itself since 1 EUR = 1 EUR
+	rate := decimal.NewFromInt(1)
+	if code != "EUR" {
+		rate, ok = rates[code]
+		if !ok {
+			return decimal.Decimal{}, false
+		}
+	}
+
+	return rate.Div(usd).Round(ecbCrossRatePrecision), true
+}
+
+// getDays will return the reference rates sorted by date in descending order. Rates are loaded from the source again once
+// refresh interval has passed. Previously loaded rates are used if loading fails or the lookup is cancelled while waiting
+// for the rates to load.
+func (e *ECBExchangeRateProvider) getDays(ctx context.Context) ([]ecbDay, error) {
+	refreshInterval := e.RefreshInterval
+	if refreshInterval == 0 {
+		refreshInterval = defaultECBRefreshInterval
+	}
+
+	e.mu.Lock()
+	days, loadedAt := e.days, e.loadedAt
+	e.mu.Unlock()
+
+	if days != nil && time.Since(loadedAt) < refreshInterval {
+		return days, nil
+	}
+
+	ch := e.group.DoChan("load", func() (interface{}, error) {
+		return e.refresh(ctx)
+	})
+
+	select {
+	case <-ctx.Done():
+		if days != nil {
+			return days, nil
+		}
+
+		return nil, ctx.Err()
+	case result := <-ch:
+		if result.Err != nil {
+			if days != nil {
+				slog.ErrorContext(ctx, "unable to refresh ECB reference rates, using previously loaded rates", "err", result.Err)
+				return days, nil
+			}
+
+			return nil, result.Err
+		}
+
+		return result.Val.([]ecbDay), nil
+	}
+}
+
+// refresh will load the reference rates from the source and swap them in. The load is detached from the lookup which
+// triggered it, thus cancelling that lookup does not fail the load for the other lookups waiting for it.
+func (e *ECBExchangeRateProvider) refresh(ctx context.Context) ([]ecbDay, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), e.getLoadTimeout())
+	defer cancel()
+
+	days, err := e.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	e.days = days
+	e.loadedAt = time.Now()
+	e.mu.Unlock()
+
+	return days, nil
+}
+
+func (e *ECBExchangeRateProvider) getLoadTimeout() time.Duration {
+	if e.LoadTimeout <= 0 {
+		return defaultECBLoadTimeout
+	}
+
+	return e.LoadTimeout
+}
+
+func (e *ECBExchangeRateProvider) load(ctx context.Context) ([]ecbDay, error) {
+	source := e.Source
+	if source == "" {
+		source = ECB_REFERENCE_RATES_URL
+	}
+
+	var r io.ReadCloser
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// the client timeout bounds reading the body as well, in case the context is not cancelled
+		client := &http.Client{Timeout: e.getLoadTimeout()}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, errors.WithMessage(err, "fetching ECB reference rates")
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("the ECB reference rates request failed with status code %v", resp.StatusCode)
+		}
+
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+
+		r = f
+	}
+	defer r.Close()
+
+	return parseECBReferenceRates(r)
+}
+
+// parseECBReferenceRates will parse the ECB euro foreign exchange reference rates XML document.
+func parseECBReferenceRates(r io.Reader) ([]ecbDay, error) {
+	var envelope ecbEnvelope
+
+	err := xml.NewDecoder(r).Decode(&envelope)
+	if err != nil {
+		return nil, errors.WithMessage(err, "decoding ECB reference rates")
+	}
+
+	days := make([]ecbDay, 0, len(envelope.Cube.Days))
+
+	for _, d := range envelope.Cube.Days {
+		date, err := time.Parse(time.DateOnly, d.Time)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ECB reference rate date '%s'", d.Time)
+		}
+
+		day := ecbDay{
+			date:  date,
+			rates: make(map[string]decimal.Decimal, len(d.Rates)),
+		}
+
+		for _, r := range d.Rates {
+			rate, err := decimal.NewFromString(r.Rate)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ECB reference rate '%s' for '%s'", r.Rate, r.Currency)
+			}
+
+			day.rates[r.Currency] = rate
+		}
+
+		days = append(days, day)
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].date.After(days[j].date)
+	})
+
+	return days, nil
+}
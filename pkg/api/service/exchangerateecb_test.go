@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+const testECBReferenceRates = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2023-12-01">
+			<Cube currency="USD" rate="1.0871"/>
+			<Cube currency="JPY" rate="160.21"/>
+			<Cube currency="GBP" rate="0.85960"/>
+		</Cube>
+		<Cube time="2023-11-30">
+			<Cube currency="USD" rate="1.0954"/>
+			<Cube currency="JPY" rate="161.84"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestECBExchangeRateProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eurofxref-hist.xml")
+	err := os.WriteFile(path, []byte(testECBReferenceRates), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &ECBExchangeRateProvider{Source: path}
+
+	tests := []struct {
+		name         string
+		purchaseDate string
+		payload      ExchangeRatePayload
+		want         ExchangeRateResponse
+		wantErr      bool
+	}{
+		{
+			name:         "should cross rate through EUR",
+			purchaseDate: "2023-12-02",
+			payload:      ExchangeRatePayload{CountryName: "Japan", Currency: "Yen"},
+			want:         ExchangeRateResponse{CountryCurrencyDesc: "Japan-Yen", ExchangeRate: "147.373747", RecordDate: "2023-12-01"},
+		},
+		{
+			name:         "should use reference rates on or before purchase date",
+			purchaseDate: "2023-11-30",
+			payload:      ExchangeRatePayload{CountryName: "Japan", Currency: "Yen"},
+			want:         ExchangeRateResponse{CountryCurrencyDesc: "Japan-Yen", ExchangeRate: "147.745116", RecordDate: "2023-11-30"},
+		},
+		{
+			name:         "should skip days without rate for the currency",
+			purchaseDate: "2023-12-01",
+			payload:      ExchangeRatePayload{CountryName: "Euro Zone", Currency: "Euro"},
+			want:         ExchangeRateResponse{CountryCurrencyDesc: "Euro Zone-Euro", ExchangeRate: "0.919879", RecordDate: "2023-12-01"},
+		},
+		{
+			name:         "should fail when there is no reference rate within 6 months",
+			purchaseDate: "2024-06-02",
+			payload:      ExchangeRatePayload{CountryName: "Japan", Currency: "Yen"},
+			wantErr:      true,
+		},
+		{
+			name:         "should use reference rates after purchase date for earliest after policy",
+			purchaseDate: "2023-11-29",
+			payload:      ExchangeRatePayload{CountryName: "Japan", Currency: "Yen", Policy: RateSelectionPolicy{Mode: EarliestAfter}},
+			want:         ExchangeRateResponse{CountryCurrencyDesc: "Japan-Yen", ExchangeRate: "147.745116", RecordDate: "2023-11-30"},
+		},
+		{
+			name:         "should fail for currency not published by ECB",
+			purchaseDate: "2023-12-01",
+			payload:      ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purchaseDate, err := time.Parse(time.DateOnly, tt.purchaseDate)
+			if err != nil {
+				t.Fatal()
+			}
+			tt.payload.RecordDate = purchaseDate
+
+			got, err := provider.GetExchangeRate(context.TODO(), tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestECBExchangeRateProviderLoad(t *testing.T) {
+	payload := ExchangeRatePayload{CountryName: "Japan", Currency: "Yen", RecordDate: time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("should share a single load which survives the cancellation of the lookup triggering it", func(t *testing.T) {
+		release := make(chan struct{})
+		var requests atomic.Int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			<-release
+			_, _ = w.Write([]byte(testECBReferenceRates))
+		}))
+		defer server.Close()
+
+		provider := &ECBExchangeRateProvider{Source: server.URL}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan error)
+		go func() {
+			_, err := provider.GetExchangeRate(ctx, payload)
+			cancelled <- err
+		}()
+
+		waiting := make(chan error)
+		go func() {
+			// wait for the first lookup to trigger the load
+			for requests.Load() == 0 {
+				time.Sleep(time.Millisecond)
+			}
+
+			_, err := provider.GetExchangeRate(context.Background(), payload)
+			waiting <- err
+		}()
+
+		for requests.Load() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		cancel()
+		assert.Equal(t, context.Canceled, <-cancelled)
+
+		close(release)
+		assert.NilError(t, <-waiting)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("should fail when loading takes longer than the load timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		provider := &ECBExchangeRateProvider{Source: server.URL, LoadTimeout: 20 * time.Millisecond}
+
+		_, err := provider.GetExchangeRate(context.Background(), payload)
+		assert.ErrorContains(t, err, "fetching ECB reference rates")
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FileExchangeRateProvider serves exchange rates from a static treasury rates of exchange dataset file which is loaded in memory once.
+type FileExchangeRateProvider struct {
+	// rates keyed by country_currency_desc, sorted by record date in descending order
+	rates map[string][]datedExchangeRate
+}
+
+type datedExchangeRate struct {
+	recordDate time.Time
+	rate       ExchangeRateResponse
+}
+
+// NewFileExchangeRateProvider will load the exchange rates from given JSON or CSV file. Format is determined by the file extension.
+func NewFileExchangeRateProvider(path string) (*FileExchangeRateProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rates, err := decodeExchangeRates(f, strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "loading exchange rates file '%s'", path)
+	}
+
+	provider := &FileExchangeRateProvider{
+		rates: make(map[string][]datedExchangeRate),
+	}
+
+	for _, er := range rates {
+		recordDate, err := time.Parse(time.DateOnly, er.RecordDate)
+		if err != nil {
+			return nil, errors.Errorf("invalid record date '%s' for '%s'", er.RecordDate, er.CountryCurrencyDesc)
+		}
+
+		provider.rates[er.CountryCurrencyDesc] = append(provider.rates[er.CountryCurrencyDesc], datedExchangeRate{recordDate: recordDate, rate: er})
+	}
+
+	for _, rates := range provider.rates {
+		sort.Slice(rates, func(i, j int) bool {
+			return rates[i].recordDate.After(rates[j].recordDate)
+		})
+	}
+
+	return provider, nil
+}
+
+// Name of the exchange rate provider.
+func (f *FileExchangeRateProvider) Name() string {
+	return FileProvider
+}
+
+// GetExchangeRate will return the rate the policy selects for the purchase date.
+func (f *FileExchangeRateProvider) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	rates := f.rates[getCountryCurrencyDesc(payload)]
+
+	recordDates := make([]time.Time, 0, len(rates))
+	for _, r := range rates {
+		recordDates = append(recordDates, r.recordDate)
+	}
+
+	selected := payload.Policy.Select(payload.RecordDate, recordDates)
+	if selected < 0 {
+		return ExchangeRateResponse{}, payload.Policy.NotFoundError()
+	}
+
+	return rates[selected].rate, nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestFileExchangeRateProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.csv")
+	err := os.WriteFile(path, []byte("record_date,country_currency_desc,exchange_rate\n2022-06-30,Nepal-Rupee,128.5\n2022-12-31,Nepal-Rupee,132.5\n2022-09-30,Nepal-Rupee,130.5\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := NewFileExchangeRateProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		purchaseDate string
+		payload      ExchangeRatePayload
+		want         string
+		wantErr      bool
+	}{
+		{name: "should return latest rate on or before purchase date", purchaseDate: "2022-11-30", payload: ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"}, want: "130.5"},
+		{name: "should return rate with same record date as purchase date", purchaseDate: "2022-12-31", payload: ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"}, want: "132.5"},
+		{name: "should fail when rate is older than 6 months", purchaseDate: "2023-07-01", payload: ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"}, wantErr: true},
+		{name: "should fail for unknown currency", purchaseDate: "2022-11-30", payload: ExchangeRatePayload{CountryName: "Nepal", Currency: "Dollar"}, wantErr: true},
+		{name: "should return nearest rate for nearest policy", purchaseDate: "2022-12-01", payload: ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee", Policy: RateSelectionPolicy{Mode: Nearest}}, want: "132.5"},
+		{name: "should return earliest rate after purchase date for earliest after policy", purchaseDate: "2022-07-01", payload: ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee", Policy: RateSelectionPolicy{Mode: EarliestAfter}}, want: "130.5"},
+		{name: "should fail when there is no rate within the quarter for same quarter policy", purchaseDate: "2023-01-01", payload: ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee", Policy: RateSelectionPolicy{Mode: SameQuarter}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purchaseDate, err := time.Parse(time.DateOnly, tt.purchaseDate)
+			if err != nil {
+				t.Fatal()
+			}
+			tt.payload.RecordDate = purchaseDate
+
+			got, err := provider.GetExchangeRate(context.TODO(), tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			assert.Equal(t, tt.want, got.ExchangeRate)
+		})
+	}
+}
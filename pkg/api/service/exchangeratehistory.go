@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/tracing"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// DefaultHistoryRange is the range of the exchange rate history when 'from' is not given
+	DefaultHistoryRange = 1
+
+	// treasury publishes a handful of records per currency every quarter, thus a single page usually holds the whole history
+	historyPageSize = 1000
+)
+
+// ExchangeRateHistoryProvider is implemented by the exchange rate providers which are able to return every rate of a
+// currency recorded within a date range.
+type ExchangeRateHistoryProvider interface {
+	// GetExchangeRateHistory will return the rates of the payload currency recorded on or after from and on or before
+	// to, ordered by record date in ascending order. RecordDate of the payload is not used.
+	GetExchangeRateHistory(ctx context.Context, payload ExchangeRatePayload, from, to time.Time) ([]ExchangeRateResponse, error)
+}
+
+// GetExchangeRateHistory will return the rates of the requested currency within the date range from the first provider
+// which has any, the same way as the lookups. When purchaseDate is given, every rate is marked with whether the rate
+// policy may select it to convert a purchase made on that date.
+func (c *ExchangeRateProviderChain) GetExchangeRateHistory(ctx context.Context, params types.ListExchangeRatesParams) (types.ExchangeRateHistory, error) {
+	from, to, err := getHistoryRange(params)
+	if err != nil {
+		return types.ExchangeRateHistory{}, err
+	}
+
+	payload := ExchangeRatePayload{CountryName: params.Country, Currency: params.Currency}
+	country, currency := getCountryAndCurrency(payload)
+
+	ctx, span := tracing.Tracer().Start(ctx, "ExchangeRateProviderChain.GetExchangeRateHistory", trace.WithAttributes(
+		attribute.String("exchange_rate.country", country),
+		attribute.String("exchange_rate.currency", currency),
+		attribute.String("exchange_rate.from", from.Format(time.DateOnly)),
+		attribute.String("exchange_rate.to", to.Format(time.DateOnly)),
+	))
+	defer span.End()
+
+	out := types.ExchangeRateHistory{
+		Country:             country,
+		Currency:            currency,
+		CountryCurrencyDesc: getCountryCurrencyDesc(payload),
+		From:                from.Format(time.DateOnly),
+		To:                  to.Format(time.DateOnly),
+		Rates:               []types.ExchangeRatePoint{},
+	}
+
+	var rates []ExchangeRateResponse
+
+	for _, provider := range c.Providers {
+		historian, ok := provider.(ExchangeRateHistoryProvider)
+		if !ok {
+			continue
+		}
+
+		var providerRates []ExchangeRateResponse
+
+		providerRates, err = historian.GetExchangeRateHistory(ctx, payload, from, to)
+		if err != nil {
+			slog.WarnContext(ctx, "exchange rate provider did not return the rate history", "provider", provider.Name(), "err", err)
+			continue
+		}
+
+		if len(providerRates) > 0 {
+			name := provider.Name()
+			out.Provider = &name
+			rates = providerRates
+
+			break
+		}
+	}
+
+	// an empty history is only an error when a provider failed, since the currency may not have any rate within the range
+	if len(rates) == 0 && err != nil {
+		tracing.RecordError(span, err)
+
+		return types.ExchangeRateHistory{}, err
+	}
+
+	var isEligible func(recordDate time.Time) bool
+
+	if params.PurchaseDate != nil {
+		purchaseDate := params.PurchaseDate.Time
+
+		var policy RateSelectionPolicy
+		if params.RatePolicy != nil {
+			policy.Mode = RateSelectionMode(*params.RatePolicy)
+		}
+
+		// same rule as the lookups, the rate must be within the window of the policy e.g. recorded on or before the
+		// purchase date from within the last 6 months
+		policy = c.getPolicy(policy)
+		eligibleFrom, eligibleTo := policy.Window(purchaseDate)
+
+		out.PurchaseDate = ptr(purchaseDate.Format(time.DateOnly))
+		out.EligibleFrom = ptr(eligibleFrom.Format(time.DateOnly))
+		out.EligibleTo = ptr(eligibleTo.Format(time.DateOnly))
+		out.RatePolicy = ptr(types.RatePolicy(policy.GetMode()))
+
+		isEligible = func(recordDate time.Time) bool {
+			return policy.InWindow(purchaseDate, recordDate)
+		}
+	}
+
+	for _, rate := range rates {
+		point := types.ExchangeRatePoint{RecordDate: rate.RecordDate, ExchangeRate: rate.ExchangeRate}
+
+		if isEligible != nil {
+			recordDate, err := time.Parse(time.DateOnly, rate.RecordDate)
+			if err != nil {
+				return types.ExchangeRateHistory{}, apiout.NewCodedError(apiout.CodeExchangeRateUnavailable, fmt.Errorf("unable to parse returned record date '%s'", rate.RecordDate))
+			}
+
+			point.Eligible = ptr(isEligible(recordDate))
+		}
+
+		out.Rates = append(out.Rates, point)
+	}
+
+	span.SetAttributes(attribute.Int("exchange_rate.count", len(out.Rates)))
+
+	return out, nil
+}
+
+// getHistoryRange will return the requested date range, which defaults to the year up to today.
+func getHistoryRange(params types.ListExchangeRatesParams) (time.Time, time.Time, error) {
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if params.To != nil {
+		to = params.To.Time
+	}
+
+	from := to.AddDate(-DefaultHistoryRange, 0, 0)
+	if params.From != nil {
+		from = params.From.Time
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, apiout.NewFieldError(apiout.CodeInvalidQueryParameter, "from", errors.New("from must be on or before to"))
+	}
+
+	return from, to, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// GetExchangeRateHistory will page through the exchange rate API for the rates within the date range.
+func (e *ExchangeRateGetter) GetExchangeRateHistory(ctx context.Context, payload ExchangeRatePayload, from, to time.Time) ([]ExchangeRateResponse, error) {
+	out := []ExchangeRateResponse{}
+
+	err := e.fetchAllPages(ctx, getHistoryRawQueryParams(payload, from, to), historyPageSize, func(rates []ExchangeRateResponse) error {
+		out = append(out, rates...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// getHistoryRawQueryParams will generate the query params of the exchange rate API call for the rates within the date range.
+func getHistoryRawQueryParams(payload ExchangeRatePayload, from, to time.Time) string {
+	country, currency := getCountryAndCurrency(payload)
+
+	filter := fmt.Sprintf("country_currency_desc:eq:%s-%s,record_date:gte:%s,record_date:lte:%s", url.QueryEscape(country), url.QueryEscape(currency), from.Format(time.DateOnly), to.Format(time.DateOnly))
+	fields := "country,currency,country_currency_desc,exchange_rate,record_date"
+	sort := "record_date"
+
+	return fmt.Sprintf("filter=%s&fields=%s&sort=%s", filter, fields, sort)
+}
+
+// GetExchangeRateHistory will return the rates within the date range from the exchange rate API, which are written
+// through to the local table. The stored rates are returned instead when the API is failing.
+func (s *ExchangeRateStore) GetExchangeRateHistory(ctx context.Context, payload ExchangeRatePayload, from, to time.Time) ([]ExchangeRateResponse, error) {
+	rates, err := s.ExchangeRateGetter.GetExchangeRateHistory(ctx, payload, from, to)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		slog.WarnContext(ctx, "exchange rate API failed, falling back to stored exchange rate history", "country_currency_desc", getCountryCurrencyDesc(payload), "err", err)
+
+		stored, storeErr := s.getStoredExchangeRateHistory(ctx, getCountryCurrencyDesc(payload), from, to)
+		if storeErr != nil || len(stored) == 0 {
+			return nil, err
+		}
+
+		return stored, nil
+	}
+
+	// failing to store the rates should not fail the request since we already have valid exchange rates
+	err = s.SaveExchangeRates(ctx, rates)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to store exchange rate history", "country_currency_desc", getCountryCurrencyDesc(payload), "err", err)
+	}
+
+	return rates, nil
+}
+
+func (s *ExchangeRateStore) getStoredExchangeRateHistory(ctx context.Context, countryCurrencyDesc string, from, to time.Time) ([]ExchangeRateResponse, error) {
+	rates, err := s.Ent.ExchangeRate.Query().
+		Where(
+			exchangerate.CountryCurrencyDesc(countryCurrencyDesc),
+			exchangerate.RecordDateGTE(from),
+			exchangerate.RecordDateLTE(to),
+		).
+		Order(exchangerate.ByRecordDate(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ExchangeRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		out = append(out, ExchangeRateResponse{
+			CountryCurrencyDesc: rate.CountryCurrencyDesc,
+			ExchangeRate:        rate.Rate.String(),
+			RecordDate:          rate.RecordDate.Format(time.DateOnly),
+		})
+	}
+
+	return out, nil
+}
+
+// GetExchangeRateHistory will return the history of the wrapped provider, which is not cached.
+func (c *ExchangeRateCache) GetExchangeRateHistory(ctx context.Context, payload ExchangeRatePayload, from, to time.Time) ([]ExchangeRateResponse, error) {
+	historian, ok := c.Provider.(ExchangeRateHistoryProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	return historian.GetExchangeRateHistory(ctx, payload, from, to)
+}
+
+// GetExchangeRateHistory will return the rates loaded from the file within the date range.
+func (f *FileExchangeRateProvider) GetExchangeRateHistory(ctx context.Context, payload ExchangeRatePayload, from, to time.Time) ([]ExchangeRateResponse, error) {
+	out := []ExchangeRateResponse{}
+
+	for _, r := range f.rates[getCountryCurrencyDesc(payload)] {
+		if !r.recordDate.Before(from) && !r.recordDate.After(to) {
+			out = append(out, r.rate)
+		}
+	}
+
+	// rates are sorted by record date in descending order
+	slices.Reverse(out)
+
+	return out, nil
+}
+
+// GetExchangeRateHistory will return the cross rates of every day of reference rates within the date range.
+func (e *ECBExchangeRateProvider) GetExchangeRateHistory(ctx context.Context, payload ExchangeRatePayload, from, to time.Time) ([]ExchangeRateResponse, error) {
+	countryCurrencyDesc := getCountryCurrencyDesc(payload)
+
+	code, ok := ecbCurrencyCodes[countryCurrencyDesc]
+	if !ok {
+		return nil, nil
+	}
+
+	days, err := e.getDays(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []ExchangeRateResponse{}
+
+	// days are sorted by date in descending order, thus they are walked backwards
+	for i := len(days) - 1; i >= 0; i-- {
+		if days[i].date.Before(from) || days[i].date.After(to) {
+			continue
+		}
+
+		rate, ok := crossRateToUSD(days[i].rates, code)
+		if !ok {
+			continue
+		}
+
+		out = append(out, ExchangeRateResponse{
+			CountryCurrencyDesc: countryCurrencyDesc,
+			ExchangeRate:        rate.String(),
+			RecordDate:          days[i].date.Format(time.DateOnly),
+		})
+	}
+
+	return out, nil
+}
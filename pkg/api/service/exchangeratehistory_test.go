@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/db"
+	"github.com/eddie023/wex-tag/pkg/treasurytest"
+	"github.com/eddie023/wex-tag/pkg/types"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gotest.tools/assert"
+)
+
+type fakeExchangeRateHistoryProvider struct {
+	fakeExchangeRateProvider
+
+	rates      []ExchangeRateResponse
+	historyErr error
+}
+
+func (f *fakeExchangeRateHistoryProvider) GetExchangeRateHistory(ctx context.Context, payload ExchangeRatePayload, from, to time.Time) ([]ExchangeRateResponse, error) {
+	return f.rates, f.historyErr
+}
+
+func TestExchangeRateProviderChainGetExchangeRateHistory(t *testing.T) {
+	date := func(s string) *openapi_types.Date {
+		d, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &openapi_types.Date{Time: d}
+	}
+
+	rates := []ExchangeRateResponse{
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "128.5", RecordDate: "2022-06-30"},
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"},
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "132.5", RecordDate: "2022-12-31"},
+	}
+
+	failing := &fakeExchangeRateHistoryProvider{fakeExchangeRateProvider: fakeExchangeRateProvider{name: "failing"}, historyErr: errors.New("service unavailable")}
+	empty := &fakeExchangeRateHistoryProvider{fakeExchangeRateProvider: fakeExchangeRateProvider{name: "empty"}}
+	found := &fakeExchangeRateHistoryProvider{fakeExchangeRateProvider: fakeExchangeRateProvider{name: "found"}, rates: rates}
+
+	tests := []struct {
+		name      string
+		providers []ExchangeRateProvider
+		params    types.ListExchangeRatesParams
+
+		wantProvider string
+		wantEligible []bool
+		wantErr      bool
+	}{
+		{
+			name:         "should return history of the first provider with any rate",
+			providers:    []ExchangeRateProvider{&fakeExchangeRateProvider{name: "unlisted"}, failing, empty, found},
+			params:       types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee", From: date("2022-01-01"), To: date("2022-12-31")},
+			wantProvider: "found",
+		},
+		{
+			name:         "should mark the rates eligible for the purchase date",
+			providers:    []ExchangeRateProvider{found},
+			params:       types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee", From: date("2022-01-01"), To: date("2022-12-31"), PurchaseDate: date("2022-12-30")},
+			wantProvider: "found",
+			wantEligible: []bool{true, true, false},
+		},
+		{
+			name:         "should not mark the rates recorded more than 6 months before the purchase date eligible",
+			providers:    []ExchangeRateProvider{found},
+			params:       types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee", From: date("2022-01-01"), To: date("2022-12-31"), PurchaseDate: date("2022-12-31")},
+			wantProvider: "found",
+			wantEligible: []bool{false, true, true},
+		},
+		{
+			name:      "should return empty history when no provider has any rate",
+			providers: []ExchangeRateProvider{empty},
+			params:    types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee"},
+		},
+		{
+			name:      "should fail when the provider failed",
+			providers: []ExchangeRateProvider{empty, failing},
+			params:    types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee"},
+			wantErr:   true,
+		},
+		{
+			name:      "should fail when from is after to",
+			providers: []ExchangeRateProvider{found},
+			params:    types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee", From: date("2023-01-01"), To: date("2022-12-31")},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &ExchangeRateProviderChain{Providers: tt.providers}
+
+			got, err := chain.GetExchangeRateHistory(context.TODO(), tt.params)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, "Nepal-Rupee", got.CountryCurrencyDesc)
+
+			if tt.wantProvider == "" {
+				assert.Assert(t, got.Provider == nil)
+				assert.Equal(t, 0, len(got.Rates))
+
+				return
+			}
+
+			assert.Equal(t, tt.wantProvider, *got.Provider)
+			assert.Equal(t, len(rates), len(got.Rates))
+
+			for i, point := range got.Rates {
+				assert.Equal(t, rates[i].RecordDate, point.RecordDate)
+
+				if tt.wantEligible == nil {
+					assert.Assert(t, point.Eligible == nil)
+					continue
+				}
+
+				assert.Equal(t, tt.wantEligible[i], *point.Eligible, "record date %s", point.RecordDate)
+			}
+		})
+	}
+}
+
+func TestExchangeRateHistoryEligibleFrom(t *testing.T) {
+	found := &fakeExchangeRateHistoryProvider{fakeExchangeRateProvider: fakeExchangeRateProvider{name: "found"}, rates: []ExchangeRateResponse{
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "128.5", RecordDate: "2022-06-30"},
+	}}
+
+	purchaseDate, err := time.Parse(time.DateOnly, "2022-12-30")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chain := &ExchangeRateProviderChain{Providers: []ExchangeRateProvider{found}}
+
+	got, err := chain.GetExchangeRateHistory(context.TODO(), types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee", PurchaseDate: &openapi_types.Date{Time: purchaseDate}})
+	assert.NilError(t, err)
+
+	assert.Equal(t, "2022-12-30", *got.PurchaseDate)
+	assert.Equal(t, "2022-06-30", *got.EligibleFrom)
+	assert.Equal(t, "2022-12-30", *got.EligibleTo)
+	assert.Equal(t, types.RatePolicyLatestOnOrBefore, *got.RatePolicy)
+	assert.Assert(t, *got.Rates[0].Eligible)
+
+	// eligibility follows the requested policy
+	sameQuarter := types.RatePolicySameQuarter
+
+	got, err = chain.GetExchangeRateHistory(context.TODO(), types.ListExchangeRatesParams{Country: "Nepal", Currency: "Rupee", PurchaseDate: &openapi_types.Date{Time: purchaseDate}, RatePolicy: &sameQuarter})
+	assert.NilError(t, err)
+
+	assert.Equal(t, "2022-10-01", *got.EligibleFrom)
+	assert.Equal(t, "2022-12-31", *got.EligibleTo)
+	assert.Equal(t, types.RatePolicySameQuarter, *got.RatePolicy)
+	assert.Assert(t, !*got.Rates[0].Eligible)
+}
+
+func TestExchangeRateGetterGetExchangeRateHistory(t *testing.T) {
+	server := treasurytest.NewServer(t)
+
+	e := &ExchangeRateGetter{BaseURL: server.URL}
+
+	got, err := e.GetExchangeRateHistory(context.TODO(), ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"}, mustParseDate(t, "2022-07-01"), mustParseDate(t, "2022-12-31"))
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []string{"2022-09-30 130.5", "2022-12-31 132.5"}, formatRates(got))
+	assert.Equal(t, 1, server.Requests())
+}
+
+func TestExchangeRateStoreGetExchangeRateHistory(t *testing.T) {
+	client := db.CreateTestDatabase(t)
+	defer client.Close()
+
+	server := treasurytest.NewServer(t)
+
+	s := &ExchangeRateStore{
+		ExchangeRateGetter: &ExchangeRateGetter{BaseURL: server.URL, Retry: RetryPolicy{MaxRetries: 1, InitialInterval: time.Millisecond}},
+		Ent:                client,
+	}
+
+	from, to := mustParseDate(t, "2022-01-01"), mustParseDate(t, "2022-12-31")
+	payload := ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"}
+
+	got, err := s.GetExchangeRateHistory(context.TODO(), payload, from, to)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"2022-06-30 128.5", "2022-09-30 130.5", "2022-12-31 132.5"}, formatRates(got))
+
+	// rates are written through, thus they are served from the table while the API is failing
+	server.Fail(http.StatusServiceUnavailable, 10)
+
+	got, err = s.GetExchangeRateHistory(context.TODO(), payload, from, to)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"2022-06-30 128.5", "2022-09-30 130.5", "2022-12-31 132.5"}, formatRates(got))
+
+	// error of the API is returned when nothing is stored
+	_, err = s.GetExchangeRateHistory(context.TODO(), ExchangeRatePayload{CountryName: "Atlantis", Currency: "Shell"}, from, to)
+
+	var aerr *apiout.APIError
+	assert.Assert(t, errors.As(err, &aerr), "got error = %v", err)
+}
+
+func TestFileExchangeRateProviderGetExchangeRateHistory(t *testing.T) {
+	provider := &FileExchangeRateProvider{rates: map[string][]datedExchangeRate{
+		"Nepal-Rupee": {
+			{recordDate: mustParseDate(t, "2022-12-31"), rate: ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "132.5", RecordDate: "2022-12-31"}},
+			{recordDate: mustParseDate(t, "2022-09-30"), rate: ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"}},
+			{recordDate: mustParseDate(t, "2022-06-30"), rate: ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "128.5", RecordDate: "2022-06-30"}},
+		},
+	}}
+
+	got, err := provider.GetExchangeRateHistory(context.TODO(), ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"}, mustParseDate(t, "2022-06-30"), mustParseDate(t, "2022-09-30"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"2022-06-30 128.5", "2022-09-30 130.5"}, formatRates(got))
+}
+
+func mustParseDate(t *testing.T, s string) time.Time {
+	t.Helper()
+
+	d, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+// formatRates will format the rates as 'record_date exchange_rate' in the given order.
+func formatRates(rates []ExchangeRateResponse) []string {
+	out := make([]string, 0, len(rates))
+	for _, r := range rates {
+		out = append(out, r.RecordDate+" "+r.ExchangeRate)
+	}
+
+	return out
+}
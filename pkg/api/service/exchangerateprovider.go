@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/eddie023/wex-tag/pkg/tracing"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// names of the supported exchange rate providers
+const (
+	TreasuryProvider = "treasury"
+	FileProvider     = "file"
+	ECBProvider      = "ecb"
+)
+
+// ExchangeRateProvider returns the exchange rate for the target currency active on the purchase date.
+// All rates are quoted as the amount of target currency for 1 USD.
+type ExchangeRateProvider interface {
+	Name() string
+	GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error)
+}
+
+// ExchangeRateProviderChain will try each of the providers in the given order until one of them returns an exchange rate.
+// A provider which fails or does not have the requested rate falls through to the next provider.
+type ExchangeRateProviderChain struct {
+	Providers []ExchangeRateProvider
+	// MaxConcurrentLookups is the maximum number of exchange rates looked up at a time when converting to multiple currencies
+	MaxConcurrentLookups int
+	// DefaultPolicy selects the rates of the lookups which do not request a policy of their own
+	DefaultPolicy RateSelectionPolicy
+	// RoundingMode rounds the converted amounts to the minor units of the target currency
+	RoundingMode money.RoundingMode
+}
+
+func (c *ExchangeRateProviderChain) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	payload.Policy = c.getPolicy(payload.Policy)
+
+	ctx, span := tracing.Tracer().Start(ctx, "ExchangeRateProviderChain.GetExchangeRate", trace.WithAttributes(
+		attribute.String("exchange_rate.country", payload.CountryName),
+		attribute.String("exchange_rate.currency", payload.Currency),
+		attribute.String("exchange_rate.purchase_date", payload.RecordDate.Format(time.DateOnly)),
+		attribute.String("exchange_rate.policy", payload.Policy.String()),
+	))
+	defer span.End()
+
+	if len(c.Providers) == 0 {
+		err := apiout.NewCodedError(apiout.CodeExchangeRateUnavailable, errors.New("no exchange rate provider is configured"))
+		tracing.RecordError(span, err)
+
+		return ExchangeRateResponse{}, err
+	}
+
+	var err error
+
+	for _, provider := range c.Providers {
+		var response ExchangeRateResponse
+
+		response, err = getProviderExchangeRate(ctx, provider, payload)
+		if err == nil {
+			response.Provider = provider.Name()
+			response.Policy = payload.Policy
+			span.SetAttributes(attribute.String("exchange_rate.provider", provider.Name()))
+
+			return response, nil
+		}
+
+		slog.WarnContext(ctx, "exchange rate provider did not return a rate", "provider", provider.Name(), "err", err)
+	}
+
+	// error of the last provider is returned since it is the final answer of the chain
+	tracing.RecordError(span, err)
+
+	return ExchangeRateResponse{}, err
+}
+
+// getPolicy will return the requested policy, falling back to the default policy of the chain for what is not requested.
+func (c *ExchangeRateProviderChain) getPolicy(requested RateSelectionPolicy) RateSelectionPolicy {
+	if requested.Mode == "" {
+		requested.Mode = c.DefaultPolicy.GetMode()
+	}
+
+	if requested.LookbackMonths <= 0 {
+		requested.LookbackMonths = c.DefaultPolicy.getLookbackMonths()
+	}
+
+	return requested
+}
+
+// getProviderExchangeRate will look up the exchange rate from a single provider within its own span.
+func getProviderExchangeRate(ctx context.Context, provider ExchangeRateProvider, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "ExchangeRateProvider.GetExchangeRate", trace.WithAttributes(
+		attribute.String("exchange_rate.provider", provider.Name()),
+	))
+	defer span.End()
+
+	response, err := provider.GetExchangeRate(ctx, payload)
+	tracing.RecordError(span, err)
+
+	return response, err
+}
+
+// ConvertCurrency will return the converted purchase amount for the provided purchase price in USD to exchange rate information.
+// Purchases made in another currency are converted through USD, looking up the exchange rate of their currency from the chain.
+func (c *ExchangeRateProviderChain) ConvertCurrency(ctx context.Context, payload ExchangeRatePayload, trans *ent.Transaction, er ExchangeRateResponse) (types.GetPurchaseTransaction, error) {
+	return convertCurrency(ctx, c, payload, trans, er, c.RoundingMode)
+}
+
+// ConvertToCurrencies will convert the purchase to each of the target currencies concurrently, selecting the rates by the policy.
+func (c *ExchangeRateProviderChain) ConvertToCurrencies(ctx context.Context, trans *ent.Transaction, targets []types.ConversionTarget, policy RateSelectionPolicy) []types.CurrencyConversionResult {
+	ctx, span := tracing.Tracer().Start(ctx, "ExchangeRateProviderChain.ConvertToCurrencies", trace.WithAttributes(
+		attribute.Int("conversion.targets", len(targets)),
+	))
+	defer span.End()
+
+	return convertToCurrencies(ctx, c, trans, targets, policy, c.RoundingMode, c.MaxConcurrentLookups)
+}
+
+// ConvertTransactions will convert each of the purchases to the target currency, looking up each distinct exchange rate only once.
+func (c *ExchangeRateProviderChain) ConvertTransactions(ctx context.Context, transactions []*ent.Transaction, target types.ConversionTarget, policy RateSelectionPolicy) []types.CurrencyConversionResult {
+	ctx, span := tracing.Tracer().Start(ctx, "ExchangeRateProviderChain.ConvertTransactions", trace.WithAttributes(
+		attribute.Int("conversion.transactions", len(transactions)),
+	))
+	defer span.End()
+
+	return convertTransactions(ctx, c, transactions, target, policy, c.RoundingMode, c.MaxConcurrentLookups)
+}
+
+// IsOutsideRateWindow reports whether the purchase cannot be converted as there is no exchange rate the rate selection
+// policy may select, e.g. within last 6 months of the purchase date.
+func IsOutsideRateWindow(err error) bool {
+	return isRateNotFound(err)
+}
+
+// errRateNotFound is returned by the providers when there is no exchange rate within last 6 months of the purchase date,
+// which is the default policy.
+var errRateNotFound = apiout.NewCodedError(apiout.CodeExchangeRateNotFound, errors.New("the purchase cannot be converted to the target currency, unable to find currency converson rate within last 6 months"))
+
+// getCountryCurrencyDesc will return the country and currency joined in the treasury 'country_currency_desc' format.
+func getCountryCurrencyDesc(payload ExchangeRatePayload) string {
+	country, currency := getCountryAndCurrency(payload)
+
+	return fmt.Sprintf("%s-%s", country, currency)
+}
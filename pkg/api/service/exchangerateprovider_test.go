@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+type fakeExchangeRateProvider struct {
+	name     string
+	response ExchangeRateResponse
+	err      error
+}
+
+func (f *fakeExchangeRateProvider) Name() string {
+	return f.name
+}
+
+func (f *fakeExchangeRateProvider) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	return f.response, f.err
+}
+
+func TestExchangeRateProviderChain(t *testing.T) {
+	rate := ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"}
+	failing := &fakeExchangeRateProvider{name: "failing", err: errors.New("service unavailable")}
+	empty := &fakeExchangeRateProvider{name: "empty", err: errRateNotFound}
+
+	tests := []struct {
+		name         string
+		providers    []ExchangeRateProvider
+		wantProvider string
+		wantErr      error
+	}{
+		{
+			name:         "should return rate from first provider",
+			providers:    []ExchangeRateProvider{&fakeExchangeRateProvider{name: "first", response: rate}, &fakeExchangeRateProvider{name: "second", response: rate}},
+			wantProvider: "first",
+		},
+		{
+			name:         "should fall through failing and empty providers",
+			providers:    []ExchangeRateProvider{failing, empty, &fakeExchangeRateProvider{name: "last", response: rate}},
+			wantProvider: "last",
+		},
+		{
+			name:      "should return error of last provider when no provider returns a rate",
+			providers: []ExchangeRateProvider{failing, empty},
+			wantErr:   errRateNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := ExchangeRateProviderChain{Providers: tt.providers}
+
+			got, err := chain.GetExchangeRate(context.TODO(), ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"})
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tt.wantProvider, got.Provider)
+			assert.Equal(t, rate.ExchangeRate, got.ExchangeRate)
+		})
+	}
+}
+
+type policyRecordingExchangeRateProvider struct {
+	policies []RateSelectionPolicy
+}
+
+func (p *policyRecordingExchangeRateProvider) Name() string {
+	return "recording"
+}
+
+func (p *policyRecordingExchangeRateProvider) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	p.policies = append(p.policies, payload.Policy)
+
+	return ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"}, nil
+}
+
+func TestExchangeRateProviderChainPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		defaultPolicy RateSelectionPolicy
+		requested     RateSelectionPolicy
+		want          RateSelectionPolicy
+	}{
+		{
+			name: "should apply latest on or before within 6 months when nothing is configured",
+			want: RateSelectionPolicy{Mode: LatestOnOrBefore, LookbackMonths: 6},
+		},
+		{
+			name:          "should apply the default policy when none is requested",
+			defaultPolicy: RateSelectionPolicy{Mode: SameQuarter, LookbackMonths: 3},
+			want:          RateSelectionPolicy{Mode: SameQuarter, LookbackMonths: 3},
+		},
+		{
+			name:          "should apply the requested mode within the default lookback",
+			defaultPolicy: RateSelectionPolicy{Mode: SameQuarter, LookbackMonths: 3},
+			requested:     RateSelectionPolicy{Mode: Nearest},
+			want:          RateSelectionPolicy{Mode: Nearest, LookbackMonths: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &policyRecordingExchangeRateProvider{}
+			chain := ExchangeRateProviderChain{Providers: []ExchangeRateProvider{provider}, DefaultPolicy: tt.defaultPolicy}
+
+			got, err := chain.GetExchangeRate(context.TODO(), ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee", Policy: tt.requested})
+			assert.NilError(t, err)
+
+			assert.DeepEqual(t, []RateSelectionPolicy{tt.want}, provider.policies)
+			assert.Equal(t, tt.want, got.Policy)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+)
+
+// ExchangeRateStore answers exchange rate lookups from the local exchange_rates table and only calls the treasury API
+// through the embedded ExchangeRateGetter when the rate is not available locally. Every rate fetched from the API is
+// written through to the table such that subsequent lookups will not depend on the treasury API.
+type ExchangeRateStore struct {
+	*ExchangeRateGetter
+
+	Ent *ent.Client
+}
+
+func (s *ExchangeRateStore) GetExchangeRate(ctx context.Context, payload ExchangeRatePayload) (ExchangeRateResponse, error) {
+	country, currency := getCountryAndCurrency(payload)
+	countryCurrencyDesc := getCountryCurrencyDesc(payload)
+
+	rate, err := s.getStoredExchangeRate(ctx, countryCurrencyDesc, payload.RecordDate, payload.Policy)
+	if err != nil && !ent.IsNotFound(err) {
+		return ExchangeRateResponse{}, err
+	}
+
+	if rate != nil {
+		slog.DebugContext(ctx, "using stored exchange rate", "country_currency_desc", countryCurrencyDesc, "record_date", rate.RecordDate)
+
+		return ExchangeRateResponse{
+			CountryCurrencyDesc: rate.CountryCurrencyDesc,
+			ExchangeRate:        rate.Rate.String(),
+			RecordDate:          rate.RecordDate.Format(time.DateOnly),
+		}, nil
+	}
+
+	slog.DebugContext(ctx, "exchange rate not found in store, falling back to exchange rate API", "country_currency_desc", countryCurrencyDesc)
+
+	response, err := s.ExchangeRateGetter.GetExchangeRate(ctx, payload)
+	if err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	// failing to store the rate should not fail the request since we already have a valid exchange rate
+	err = s.SaveExchangeRate(ctx, country, currency, response)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to store exchange rate", "country_currency_desc", response.CountryCurrencyDesc, "err", err)
+	}
+
+	return response, nil
+}
+
+// getStoredExchangeRate will return the stored rate the policy selects for the purchase date, nil when there is none.
+// Since rates are only written through for the lookups which reached the treasury API, the table may miss records which
+// were already published. Thus the stored rates are only used when every quarter-end record the policy depends on is
+// stored, otherwise a newer or nearer record may have been published since.
+func (s *ExchangeRateStore) getStoredExchangeRate(ctx context.Context, countryCurrencyDesc string, purchaseDate time.Time, policy RateSelectionPolicy) (*ent.ExchangeRate, error) {
+	expected := getExpectedRecordDates(policy, purchaseDate, time.Now())
+	if len(expected) == 0 {
+		return nil, nil
+	}
+
+	// treasury API matches the country and currency case insensitively, thus the store does the same
+	count, err := s.Ent.ExchangeRate.Query().
+		Where(
+			exchangerate.CountryCurrencyDescEqualFold(countryCurrencyDesc),
+			exchangerate.RecordDateIn(expected...),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if count < len(expected) {
+		return nil, nil
+	}
+
+	from, to := policy.Window(purchaseDate)
+
+	query := s.Ent.ExchangeRate.Query().
+		Where(
+			exchangerate.CountryCurrencyDescEqualFold(countryCurrencyDesc),
+			exchangerate.RecordDateLTE(to),
+			exchangerate.RecordDateGTE(from),
+		)
+
+	switch policy.GetMode() {
+	case Nearest:
+		rates, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		recordDates := make([]time.Time, 0, len(rates))
+		for _, rate := range rates {
+			recordDates = append(recordDates, rate.RecordDate)
+		}
+
+		selected := policy.Select(purchaseDate, recordDates)
+		if selected < 0 {
+			return nil, nil
+		}
+
+		return rates[selected], nil
+	case EarliestAfter:
+		return query.Order(exchangerate.ByRecordDate(sql.OrderAsc())).First(ctx)
+	default:
+		return query.Order(exchangerate.ByRecordDate(sql.OrderDesc())).First(ctx)
+	}
+}
+
+// getExpectedRecordDates will return the quarter-end record dates which have to be stored for the policy to select the rate
+// of the purchase date from the store, none when the selection cannot be decided by stored rates e.g. since the
+// quarter-end the policy depends on is not published yet.
+func getExpectedRecordDates(policy RateSelectionPolicy, purchaseDate time.Time, now time.Time) []time.Time {
+	day := getDay(purchaseDate)
+	// treasury publishes a record for every quarter-end, thus the quarter-ends around the purchase date are known to exist once passed
+	before := getQuarterEndOnOrBefore(day)
+	after := getQuarterStart(day).AddDate(0, 3, -1)
+	published := func(d time.Time) bool {
+		return !d.After(now)
+	}
+
+	switch policy.GetMode() {
+	case SameQuarter:
+		if !published(after) {
+			return nil
+		}
+
+		return []time.Time{after}
+	case EarliestAfter:
+		if !published(after) || !policy.InWindow(purchaseDate, after) {
+			return nil
+		}
+
+		return []time.Time{after}
+	case Nearest:
+		if !policy.InWindow(purchaseDate, before) {
+			return nil
+		}
+
+		// the quarter-end after the purchase date may be nearer, unless it is the purchase date itself or is not published yet
+		if after.Equal(before) || !published(after) || !policy.InWindow(purchaseDate, after) {
+			return []time.Time{before}
+		}
+
+		return []time.Time{before, after}
+	default:
+		if !policy.InWindow(purchaseDate, before) {
+			return nil
+		}
+
+		return []time.Time{before}
+	}
+}
+
+// SaveExchangeRate will store the given exchange rate. Rate which is already stored for the same record date is updated with the given rate.
+func (s *ExchangeRateStore) SaveExchangeRate(ctx context.Context, country, currency string, er ExchangeRateResponse) error {
+	er.Country = country
+	er.Currency = currency
+
+	return s.SaveExchangeRates(ctx, []ExchangeRateResponse{er})
+}
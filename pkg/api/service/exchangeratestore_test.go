@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/db"
+	"gotest.tools/assert"
+)
+
+func TestExchangeRateStoreGetExchangeRate(t *testing.T) {
+	client := db.CreateTestDatabase(t)
+	defer client.Close()
+
+	// fallback is not set, thus any lookup reaching the exchange rate API would panic
+	s := ExchangeRateStore{Ent: client}
+
+	for _, er := range []ExchangeRateResponse{
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "128.5", RecordDate: "2022-06-30"},
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"},
+		{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "132.5", RecordDate: "2022-12-31"},
+	} {
+		err := s.SaveExchangeRate(context.TODO(), "Nepal", "Rupee", er)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		purchaseDate string
+		payload      ExchangeRatePayload
+		want         ExchangeRateResponse
+	}{
+		{
+			name:         "should return latest stored rate on or before purchase date",
+			purchaseDate: "2022-11-30",
+			payload:      ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"},
+			want:         ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"},
+		},
+		{
+			name:         "should return stored rate with same record date as purchase date",
+			purchaseDate: "2022-12-31",
+			payload:      ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"},
+			want:         ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "132.5", RecordDate: "2022-12-31"},
+		},
+		{
+			name:         "should trim quotes from country and currency",
+			purchaseDate: "2022-07-01",
+			payload:      ExchangeRatePayload{CountryName: `"Nepal"`, Currency: `'Rupee'`},
+			want:         ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "128.5", RecordDate: "2022-06-30"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purchaseDate, err := time.Parse(time.DateOnly, tt.purchaseDate)
+			if err != nil {
+				t.Fatal()
+			}
+
+			tt.payload.RecordDate = purchaseDate
+
+			got, err := s.GetExchangeRate(context.TODO(), tt.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetExpectedRecordDates(t *testing.T) {
+	now := time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		purchaseDate string
+		policy       RateSelectionPolicy
+		want         []string
+	}{
+		{name: "should expect the quarter-end before purchase date", purchaseDate: "2023-02-15", want: []string{"2022-12-31"}},
+		{name: "should expect the purchase date when it is a quarter-end", purchaseDate: "2023-03-31", want: []string{"2023-03-31"}},
+		{name: "should expect nothing when the quarter-end is outside the lookback window", purchaseDate: "2023-02-15", policy: RateSelectionPolicy{LookbackMonths: 1}},
+		{name: "should expect the quarter-ends on both sides for nearest policy", purchaseDate: "2023-02-15", policy: RateSelectionPolicy{Mode: Nearest}, want: []string{"2022-12-31", "2023-03-31"}},
+		{name: "should expect only the published quarter-end for nearest policy", purchaseDate: "2023-11-01", policy: RateSelectionPolicy{Mode: Nearest}, want: []string{"2023-09-30"}},
+		{name: "should expect the quarter-end after purchase date for earliest after policy", purchaseDate: "2023-02-15", policy: RateSelectionPolicy{Mode: EarliestAfter}, want: []string{"2023-03-31"}},
+		{name: "should expect nothing for earliest after policy until the quarter-end is published", purchaseDate: "2023-11-01", policy: RateSelectionPolicy{Mode: EarliestAfter}},
+		{name: "should expect the end of the quarter for same quarter policy", purchaseDate: "2023-02-15", policy: RateSelectionPolicy{Mode: SameQuarter}, want: []string{"2023-03-31"}},
+		{name: "should expect nothing for same quarter policy until the quarter ended", purchaseDate: "2023-11-01", policy: RateSelectionPolicy{Mode: SameQuarter}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purchaseDate, err := time.Parse(time.DateOnly, tt.purchaseDate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, d := range getExpectedRecordDates(tt.policy, purchaseDate, now) {
+				got = append(got, d.Format(time.DateOnly))
+			}
+
+			assert.DeepEqual(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetStoredExchangeRate(t *testing.T) {
+	client := db.CreateTestDatabase(t)
+	defer client.Close()
+
+	s := ExchangeRateStore{Ent: client}
+
+	er := ExchangeRateResponse{CountryCurrencyDesc: "Nepal-Rupee", ExchangeRate: "130.5", RecordDate: "2022-09-30"}
+
+	// storing the same rate twice should not fail
+	for i := 0; i < 2; i++ {
+		err := s.SaveExchangeRate(context.TODO(), "Nepal", "Rupee", er)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := client.ExchangeRate.Query().Count(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, count)
+
+	tests := []struct {
+		name                string
+		countryCurrencyDesc string
+		purchaseDate        string
+		policy              RateSelectionPolicy
+		wantNotFound        bool
+	}{
+		{name: "should find rate when the quarter-end before purchase date is stored", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-11-30"},
+		{name: "should find rate regardless of case", countryCurrencyDesc: "NEPAL-RUPEE", purchaseDate: "2022-11-30"},
+		{name: "should not find stale rate when a newer quarter-end is not stored", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2023-02-15", wantNotFound: true},
+		{name: "should not find rate after purchase date", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-09-29", wantNotFound: true},
+		{name: "should not find rate for other currency", countryCurrencyDesc: "Nepal-Dollar", purchaseDate: "2022-11-30", wantNotFound: true},
+		{name: "should not find rate older than the lookback window", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-11-30", policy: RateSelectionPolicy{LookbackMonths: 1}, wantNotFound: true},
+		{name: "should find rate after purchase date for earliest after policy", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-09-29", policy: RateSelectionPolicy{Mode: EarliestAfter}},
+		{name: "should not find rate for earliest after policy when the next quarter-end is not stored", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-10-01", policy: RateSelectionPolicy{Mode: EarliestAfter}, wantNotFound: true},
+		{name: "should find rate for nearest policy when the only quarter-end within the window is stored", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-10-15", policy: RateSelectionPolicy{Mode: Nearest, LookbackMonths: 1}},
+		{name: "should not find rate for nearest policy when the quarter-end before is not stored", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-09-29", policy: RateSelectionPolicy{Mode: Nearest}, wantNotFound: true},
+		{name: "should not find rate outside the window for nearest policy", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-12-31", policy: RateSelectionPolicy{Mode: Nearest, LookbackMonths: 1}, wantNotFound: true},
+		{name: "should find rate of the quarter for same quarter policy", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-08-01", policy: RateSelectionPolicy{Mode: SameQuarter}},
+		{name: "should not find rate of another quarter for same quarter policy", countryCurrencyDesc: "Nepal-Rupee", purchaseDate: "2022-10-01", policy: RateSelectionPolicy{Mode: SameQuarter}, wantNotFound: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purchaseDate, err := time.Parse(time.DateOnly, tt.purchaseDate)
+			if err != nil {
+				t.Fatal()
+			}
+
+			rate, err := s.getStoredExchangeRate(context.TODO(), tt.countryCurrencyDesc, purchaseDate, tt.policy)
+			if tt.wantNotFound {
+				assert.Assert(t, rate == nil && (err == nil || ent.IsNotFound(err)), "got rate = %v, error = %v", rate, err)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, "2022-09-30", rate.RecordDate.Format(time.DateOnly))
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/ent/exchangerate"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// treasury API allows page size of up to 10000 records but we keep it lower to stay friendly with its rate limiting
+	DefaultSyncPageSize = 1000
+
+	saveBatchSize = 1000
+)
+
+// SyncExchangeRates will page through the whole treasury rates of exchange dataset and store every record.
+// When since is provided, only the records with record date on or after since are synced.
+// It returns the number of records stored.
+func (s *ExchangeRateStore) SyncExchangeRates(ctx context.Context, since *time.Time, pageSize int) (int, error) {
+	if pageSize < 1 {
+		pageSize = DefaultSyncPageSize
+	}
+
+	total := 0
+
+	err := s.fetchAllPages(ctx, getSyncRawQueryParams(since), pageSize, func(rates []ExchangeRateResponse) error {
+		err := s.saveInBatches(ctx, rates)
+		if err != nil {
+			return err
+		}
+
+		total += len(rates)
+
+		return nil
+	})
+
+	return total, err
+}
+
+// fetchAllPages will page through the exchange rate API for the given raw query params and call fn with the records of every page.
+func (e *ExchangeRateGetter) fetchAllPages(ctx context.Context, query string, pageSize int, fn func(rates []ExchangeRateResponse) error) error {
+	page := fmt.Sprintf("&page[number]=1&page[size]=%d", pageSize)
+
+	for {
+		slog.DebugContext(ctx, "fetching exchange rates page", "page", page)
+
+		response, err := e.FetchExchangeRates(ctx, query+page)
+		if err != nil {
+			return err
+		}
+
+		err = fn(response.Data)
+		if err != nil {
+			return err
+		}
+
+		if response.Links.Next == nil || len(response.Data) == 0 {
+			return nil
+		}
+
+		page = *response.Links.Next
+	}
+}
+
+// ImportExchangeRates will store every record from a previously downloaded treasury rates of exchange dataset.
+// Supported formats are 'json', which is the exchange rate API response body, and 'csv' as downloaded from the treasury website or API.
+// When since is provided, records with record date before since are skipped. It returns the number of records stored.
+func (s *ExchangeRateStore) ImportExchangeRates(ctx context.Context, r io.Reader, format string, since *time.Time) (int, error) {
+	rates, err := decodeExchangeRates(r, format)
+	if err != nil {
+		return 0, err
+	}
+
+	if since != nil {
+		rates, err = filterExchangeRatesSince(rates, *since)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = s.saveInBatches(ctx, rates)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(rates), nil
+}
+
+// decodeExchangeRates will decode the treasury rates of exchange dataset in either 'json' or 'csv' format.
+func decodeExchangeRates(r io.Reader, format string) ([]ExchangeRateResponse, error) {
+	switch format {
+	case "json":
+		var response ExchangeRateAPIResponse
+
+		err := json.NewDecoder(r).Decode(&response)
+		if err != nil {
+			return nil, errors.WithMessage(err, "decoding json")
+		}
+
+		return response.Data, nil
+	case "csv":
+		rates, err := parseExchangeRatesCSV(r)
+		if err != nil {
+			return nil, errors.WithMessage(err, "parseExchangeRatesCSV")
+		}
+
+		return rates, nil
+	default:
+		return nil, fmt.Errorf("unsupported exchange rates format '%s'", format)
+	}
+}
+
+func (s *ExchangeRateStore) saveInBatches(ctx context.Context, rates []ExchangeRateResponse) error {
+	for start := 0; start < len(rates); start += saveBatchSize {
+		end := min(start+saveBatchSize, len(rates))
+
+		err := s.SaveExchangeRates(ctx, rates[start:end])
+		if err != nil {
+			return errors.WithMessage(err, "SaveExchangeRates")
+		}
+	}
+
+	return nil
+}
+
+// SaveExchangeRates will store all given exchange rates in a single query. Rates which are already stored for the same record date are updated.
+func (s *ExchangeRateStore) SaveExchangeRates(ctx context.Context, rates []ExchangeRateResponse) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	// same record cannot be updated twice within a single upsert, thus the last occurrence wins
+	seen := make(map[string]int, len(rates))
+	builders := make([]*ent.ExchangeRateCreate, 0, len(rates))
+
+	for _, er := range rates {
+		rate, err := decimal.NewFromString(er.ExchangeRate)
+		if err != nil {
+			return fmt.Errorf("invalid exchange rate '%s' for '%s'", er.ExchangeRate, er.CountryCurrencyDesc)
+		}
+
+		recordDate, err := time.Parse(time.DateOnly, er.RecordDate)
+		if err != nil {
+			return fmt.Errorf("invalid record date '%s' for '%s'", er.RecordDate, er.CountryCurrencyDesc)
+		}
+
+		country, currency := er.Country, er.Currency
+		if country == "" || currency == "" {
+			country, currency = splitCountryCurrencyDesc(er.CountryCurrencyDesc)
+		}
+
+		builder := s.Ent.ExchangeRate.Create().
+			SetCountry(country).
+			SetCurrency(currency).
+			SetCountryCurrencyDesc(er.CountryCurrencyDesc).
+			SetRate(rate).
+			SetRecordDate(recordDate)
+
+		key := er.CountryCurrencyDesc + "|" + er.RecordDate
+		if i, ok := seen[key]; ok {
+			builders[i] = builder
+			continue
+		}
+
+		seen[key] = len(builders)
+		builders = append(builders, builder)
+	}
+
+	return s.Ent.ExchangeRate.CreateBulk(builders...).
+		OnConflictColumns(exchangerate.FieldCountryCurrencyDesc, exchangerate.FieldRecordDate).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
+// getSyncRawQueryParams will generate the query params, without the pagination, to fetch the whole exchange rate dataset.
+func getSyncRawQueryParams(since *time.Time) string {
+	fields := "country,currency,country_currency_desc,exchange_rate,record_date"
+	// sort by record_date in ascending order such that the pages are stable while new records are being published
+	sort := "record_date,country_currency_desc"
+
+	output := fmt.Sprintf("fields=%s&sort=%s", fields, sort)
+
+	if since != nil {
+		output += fmt.Sprintf("&filter=record_date:gte:%s", since.Format(time.DateOnly))
+	}
+
+	return output
+}
+
+func filterExchangeRatesSince(rates []ExchangeRateResponse, since time.Time) ([]ExchangeRateResponse, error) {
+	filtered := make([]ExchangeRateResponse, 0, len(rates))
+
+	for _, er := range rates {
+		recordDate, err := time.Parse(time.DateOnly, er.RecordDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid record date '%s' for '%s'", er.RecordDate, er.CountryCurrencyDesc)
+		}
+
+		if recordDate.Before(since) {
+			continue
+		}
+
+		filtered = append(filtered, er)
+	}
+
+	return filtered, nil
+}
+
+// parseExchangeRatesCSV will parse the exchange rate records from CSV. Columns are matched by their header, which can either be
+// the API field names such as 'record_date' or the treasury website labels such as 'Record Date'. Unknown columns are ignored.
+func parseExchangeRatesCSV(r io.Reader) ([]ExchangeRateResponse, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[normalizeCSVHeader(h)] = i
+	}
+
+	for _, required := range []string{"recorddate", "exchangerate"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing required column '%s'", required)
+		}
+	}
+
+	descColumn, hasDesc := columns["countrycurrencydesc"]
+	if !hasDesc {
+		descColumn, hasDesc = columns["countrycurrencydescription"]
+	}
+
+	countryColumn, hasCountry := columns["country"]
+	currencyColumn, hasCurrency := columns["currency"]
+
+	if !hasDesc && !(hasCountry && hasCurrency) {
+		return nil, errors.New("missing required column 'country_currency_desc' or 'country' and 'currency'")
+	}
+
+	var rates []ExchangeRateResponse
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		get := func(i int) string {
+			if i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		er := ExchangeRateResponse{
+			ExchangeRate: get(columns["exchangerate"]),
+			RecordDate:   get(columns["recorddate"]),
+		}
+
+		if hasCountry && hasCurrency {
+			er.Country = get(countryColumn)
+			er.Currency = get(currencyColumn)
+		}
+
+		if hasDesc {
+			er.CountryCurrencyDesc = get(descColumn)
+		} else {
+			er.CountryCurrencyDesc = fmt.Sprintf("%s-%s", er.Country, er.Currency)
+		}
+
+		rates = append(rates, er)
+	}
+
+	return rates, nil
+}
+
+// normalizeCSVHeader will only keep the lowercase letters of the header such that 'Record Date' and 'record_date' are treated the same.
+func normalizeCSVHeader(h string) string {
+	var b strings.Builder
+
+	for _, r := range strings.ToLower(h) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// splitCountryCurrencyDesc will split the country currency description at the last '-' since country names such as
+// 'Bosnia-Hercegovina' can contain the separator as well.
+func splitCountryCurrencyDesc(desc string) (string, string) {
+	i := strings.LastIndex(desc, "-")
+	if i < 0 {
+		return desc, ""
+	}
+
+	return desc[:i], desc[i+1:]
+}
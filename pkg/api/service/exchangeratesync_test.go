@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/db"
+	"github.com/eddie023/wex-tag/pkg/treasurytest"
+	"gotest.tools/assert"
+)
+
+func TestImportExchangeRates(t *testing.T) {
+	since, err := time.Parse(time.DateOnly, "2022-09-30")
+	if err != nil {
+		t.Fatal()
+	}
+
+	tests := []struct {
+		name      string
+		format    string
+		given     string
+		since     *time.Time
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name:      "should import exchange rate API response body",
+			format:    "json",
+			given:     `{"data":[{"country":"Nepal","currency":"Rupee","country_currency_desc":"Nepal-Rupee","exchange_rate":"130.5","record_date":"2022-09-30"},{"country":"Nepal","currency":"Rupee","country_currency_desc":"Nepal-Rupee","exchange_rate":"128.5","record_date":"2022-06-30"}],"links":{"next":null}}`,
+			wantCount: 2,
+		},
+		{
+			name:      "should import csv with API field names",
+			format:    "csv",
+			given:     "record_date,country,currency,country_currency_desc,exchange_rate\n2022-09-30,Nepal,Rupee,Nepal-Rupee,130.5\n2022-06-30,Nepal,Rupee,Nepal-Rupee,128.5\n",
+			wantCount: 2,
+		},
+		{
+			name:      "should import csv downloaded from treasury website and skip rates before since",
+			format:    "csv",
+			given:     "Record Date,Country,Currency,Country - Currency Description,Exchange Rate,Effective Date\n2022-09-30,Nepal,Rupee,Nepal-Rupee,130.5,2022-09-30\n2022-06-30,Nepal,Rupee,Nepal-Rupee,128.5,2022-06-30\n",
+			since:     &since,
+			wantCount: 1,
+		},
+		{
+			name:    "should fail for csv without exchange rate column",
+			format:  "csv",
+			given:   "record_date,country_currency_desc\n2022-09-30,Nepal-Rupee\n",
+			wantErr: true,
+		},
+		{
+			name:    "should fail for invalid exchange rate",
+			format:  "json",
+			given:   `{"data":[{"country_currency_desc":"Nepal-Rupee","exchange_rate":"abcd","record_date":"2022-09-30"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "should fail for unsupported format",
+			format:  "xml",
+			given:   `<data></data>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := db.CreateTestDatabase(t)
+			defer client.Close()
+
+			s := ExchangeRateStore{Ent: client}
+
+			count, err := s.ImportExchangeRates(context.TODO(), strings.NewReader(tt.given), tt.format, tt.since)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			assert.Equal(t, tt.wantCount, count)
+
+			rates, err := client.ExchangeRate.Query().All(context.TODO())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.wantCount, len(rates))
+			for _, rate := range rates {
+				assert.Equal(t, "Nepal", rate.Country)
+				assert.Equal(t, "Rupee", rate.Currency)
+				assert.Equal(t, "Nepal-Rupee", rate.CountryCurrencyDesc)
+			}
+		})
+	}
+}
+
+func TestSyncExchangeRates(t *testing.T) {
+	since, err := time.Parse(time.DateOnly, "2022-09-30")
+	if err != nil {
+		t.Fatal()
+	}
+
+	tests := []struct {
+		name          string
+		since         *time.Time
+		pageSize      int
+		wantCount     int
+		wantRequests  int
+		wantRateLimit int
+	}{
+		{name: "should follow next links until the last page", pageSize: 5, wantCount: 12, wantRequests: 3},
+		{name: "should only sync rates on or after since", since: &since, pageSize: 5, wantCount: 9, wantRequests: 2},
+		{name: "should retry rate limited pages", pageSize: 100, wantCount: 12, wantRequests: 2, wantRateLimit: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := db.CreateTestDatabase(t)
+			defer client.Close()
+
+			server := treasurytest.NewServer(t)
+			server.RateLimit(tt.wantRateLimit)
+
+			s := ExchangeRateStore{
+				ExchangeRateGetter: &ExchangeRateGetter{BaseURL: server.URL, Retry: RetryPolicy{InitialInterval: time.Millisecond}},
+				Ent:                client,
+			}
+
+			count, err := s.SyncExchangeRates(context.TODO(), tt.since, tt.pageSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.wantCount, count)
+			assert.Equal(t, tt.wantRequests, server.Requests())
+
+			stored, err := client.ExchangeRate.Query().Count(context.TODO())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.wantCount, stored)
+		})
+	}
+}
+
+func TestSaveExchangeRates(t *testing.T) {
+	client := db.CreateTestDatabase(t)
+	defer client.Close()
+
+	s := ExchangeRateStore{Ent: client}
+
+	err := s.SaveExchangeRates(context.TODO(), []ExchangeRateResponse{
+		{CountryCurrencyDesc: "Bosnia-Hercegovina-Marka", ExchangeRate: "1.8", RecordDate: "2022-09-30"},
+		{CountryCurrencyDesc: "Bosnia-Hercegovina-Marka", ExchangeRate: "1.9", RecordDate: "2022-09-30"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// previously stored rate should be updated
+	err = s.SaveExchangeRates(context.TODO(), []ExchangeRateResponse{
+		{CountryCurrencyDesc: "Bosnia-Hercegovina-Marka", ExchangeRate: "2.0", RecordDate: "2022-09-30"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rates, err := client.ExchangeRate.Query().All(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, len(rates))
+	assert.Equal(t, "Bosnia-Hercegovina", rates[0].Country)
+	assert.Equal(t, "Marka", rates[0].Currency)
+	assert.Equal(t, "2", rates[0].Rate.String())
+}
+
+func TestGetSyncRawQueryParams(t *testing.T) {
+	since, err := time.Parse(time.DateOnly, "2023-01-01")
+	if err != nil {
+		t.Fatal()
+	}
+
+	tests := []struct {
+		name  string
+		since *time.Time
+		want  string
+	}{
+		{
+			name: "should fetch whole dataset",
+			want: "fields=country,currency,country_currency_desc,exchange_rate,record_date&sort=record_date,country_currency_desc",
+		},
+		{
+			name:  "should filter by since date",
+			since: &since,
+			want:  "fields=country,currency,country_currency_desc,exchange_rate,record_date&sort=record_date,country_currency_desc&filter=record_date:gte:2023-01-01",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getSyncRawQueryParams(tt.since))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/ent/idempotencykey"
+	"github.com/eddie023/wex-tag/ent/predicate"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultIdempotencyKeyTTL = 24 * time.Hour
+	// DefaultIdempotencyKeyLease is how long a key is reserved for a request which has not completed yet
+	DefaultIdempotencyKeyLease = time.Minute
+)
+
+// IdempotencyStore keeps track of the requests made with an idempotency key and their responses such that
+// a retried request can be answered with the original response.
+type IdempotencyStore struct {
+	Ent *ent.Client
+	// TTL is how long a key is remembered after its request completed
+	TTL time.Duration
+	// Lease is how long a key is reserved while its request is being processed. Keys whose request was abandoned, e.g. since
+	// the process crashed before completing it, can be reclaimed once the lease expired. Keep it above the request deadline
+	Lease time.Duration
+}
+
+// ErrIdempotencyLeaseLost is returned when completing or releasing a reservation whose lease expired and whose key was
+// released or reclaimed by another request since.
+var ErrIdempotencyLeaseLost = errors.New("idempotency key lease was lost")
+
+// IdempotentResponse is the stored response of a previously completed request.
+type IdempotentResponse struct {
+	Status int
+	Body   []byte
+}
+
+// IdempotencyReservation is the claim of a request on an idempotency key, which is required to complete or release the key.
+type IdempotencyReservation struct {
+	Key         string
+	RequestHash string
+	// ReservedAt is the start of the lease, which tells the reservation apart from a later one reclaiming the same key
+	ReservedAt time.Time
+}
+
+// Reserve will claim the idempotency key for the request with given hash. It returns the stored response instead when the
+// key was already used for a completed request with the same hash. It fails with 409 when the original request is still
+// being processed and with 422 when the key was used for a different request. Expired keys, either completed ones past
+// their TTL or abandoned ones past their lease, are reclaimed.
+func (s *IdempotencyStore) Reserve(ctx context.Context, key string, requestHash string) (IdempotencyReservation, *IdempotentResponse, error) {
+	// postgres stores timestamps with microsecond precision, thus the lease start is truncated to match the stored one
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	lease := s.Lease
+	if lease == 0 {
+		lease = DefaultIdempotencyKeyLease
+	}
+
+	for reclaimed := false; ; reclaimed = true {
+		err := s.Ent.IdempotencyKey.Create().
+			SetKey(key).
+			SetRequestHash(requestHash).
+			SetCreatedAt(now).
+			SetExpiresAt(now.Add(lease)).
+			Exec(ctx)
+		if err == nil {
+			return IdempotencyReservation{Key: key, RequestHash: requestHash, ReservedAt: now}, nil, nil
+		}
+
+		if !ent.IsConstraintError(err) {
+			return IdempotencyReservation{}, nil, errors.WithMessage(err, "creating idempotency key")
+		}
+
+		// the key is already used, check if we can reclaim it or replay the response
+		existing, err := s.Ent.IdempotencyKey.Query().Where(idempotencykey.Key(key)).Only(ctx)
+		if err != nil {
+			return IdempotencyReservation{}, nil, errors.WithMessage(err, "querying idempotency key")
+		}
+
+		if !existing.ExpiresAt.After(now) && !reclaimed {
+			// only the expired key is deleted, thus a concurrent request which reclaimed it first keeps its reservation
+			_, err := s.Ent.IdempotencyKey.Delete().Where(idempotencykey.Key(key), idempotencykey.ExpiresAtLTE(now)).Exec(ctx)
+			if err != nil {
+				return IdempotencyReservation{}, nil, errors.WithMessage(err, "deleting expired idempotency key")
+			}
+
+			slog.InfoContext(ctx, "reclaiming expired idempotency key", "idempotency_key", key, "completed", existing.ResponseStatus != nil)
+
+			continue
+		}
+
+		if existing.RequestHash != requestHash {
+			return IdempotencyReservation{}, nil, apiout.NewCodedError(apiout.CodeIdempotencyKeyReused, errors.New("idempotency key has already been used with a different request body"))
+		}
+
+		if existing.ResponseStatus == nil {
+			return IdempotencyReservation{}, nil, apiout.NewCodedError(apiout.CodeIdempotencyKeyInProgress, errors.New("a request with the same idempotency key is still being processed"))
+		}
+
+		slog.InfoContext(ctx, "replaying response for idempotency key", "idempotency_key", key)
+
+		return IdempotencyReservation{}, &IdempotentResponse{
+			Status: *existing.ResponseStatus,
+			Body:   existing.ResponseBody,
+		}, nil
+	}
+}
+
+// Complete will store the response of the request which reserved the idempotency key, which is remembered for the TTL from
+// now on. It fails with ErrIdempotencyLeaseLost when the key was reclaimed by another request since, such that the response
+// of that request is not overwritten.
+func (s *IdempotencyStore) Complete(ctx context.Context, reservation IdempotencyReservation, response IdempotentResponse) error {
+	ttl := s.TTL
+	if ttl == 0 {
+		ttl = DefaultIdempotencyKeyTTL
+	}
+
+	updated, err := s.Ent.IdempotencyKey.Update().
+		Where(reservation.predicate()).
+		SetResponseStatus(response.Status).
+		SetResponseBody(response.Body).
+		SetExpiresAt(time.Now().UTC().Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		return ErrIdempotencyLeaseLost
+	}
+
+	return nil
+}
+
+// Release will forget the idempotency key such that the request can be retried, e.g. when the original request failed.
+// It fails with ErrIdempotencyLeaseLost when the key was reclaimed by another request since, which keeps its reservation.
+func (s *IdempotencyStore) Release(ctx context.Context, reservation IdempotencyReservation) error {
+	deleted, err := s.Ent.IdempotencyKey.Delete().Where(reservation.predicate()).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrIdempotencyLeaseLost
+	}
+
+	return nil
+}
+
+// predicate matches the idempotency key only while it is still reserved by the reservation.
+func (r IdempotencyReservation) predicate() predicate.IdempotencyKey {
+	return idempotencykey.And(
+		idempotencykey.Key(r.Key),
+		idempotencykey.RequestHash(r.RequestHash),
+		idempotencykey.CreatedAt(r.ReservedAt),
+	)
+}
+
+// DeleteExpired will remove the keys which expired and returns the number of removed keys. Expired keys are reclaimed by
+// Reserve regardless, thus this only keeps the table from growing.
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context) (int, error) {
+	return s.Ent.IdempotencyKey.Delete().Where(idempotencykey.ExpiresAtLTE(time.Now().UTC())).Exec(ctx)
+}
+
+// RunExpiryCleanup will delete the expired keys every interval until the context is cancelled.
+func (s *IdempotencyStore) RunExpiryCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.DeleteExpired(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to delete expired idempotency keys", "err", err.Error())
+				continue
+			}
+
+			slog.DebugContext(ctx, "deleted expired idempotency keys", "count", deleted)
+		}
+	}
+}
+
+// HashRequest will return the sha256 hash of the JSON encoded request payload. Since the payload is decoded first,
+// formatting differences of the same request body such as whitespace will result in the same hash.
+func HashRequest(payload any) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:]), nil
+}
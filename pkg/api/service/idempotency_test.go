@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/ent/idempotencykey"
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/db"
+	"github.com/eddie023/wex-tag/pkg/types"
+	"gotest.tools/assert"
+)
+
+func TestIdempotencyStore(t *testing.T) {
+	client := db.CreateTestDatabase(t)
+	defer client.Close()
+
+	s := IdempotencyStore{Ent: client}
+	ctx := context.TODO()
+
+	reservation, stored, err := s.Reserve(ctx, "key", "hash")
+	assert.NilError(t, err)
+	assert.Assert(t, stored == nil)
+
+	t.Run("should fail with conflict while original request is being processed", func(t *testing.T) {
+		_, _, err := s.Reserve(ctx, "key", "hash")
+		assertHttpStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("should fail with unprocessable entity for different request", func(t *testing.T) {
+		_, _, err := s.Reserve(ctx, "key", "other hash")
+		assertHttpStatus(t, err, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("should replay completed response", func(t *testing.T) {
+		err := s.Complete(ctx, reservation, IdempotentResponse{Status: http.StatusCreated, Body: []byte(`{"id":"1"}`)})
+		assert.NilError(t, err)
+
+		_, stored, err := s.Reserve(ctx, "key", "hash")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &IdempotentResponse{Status: http.StatusCreated, Body: []byte(`{"id":"1"}`)}, stored)
+	})
+
+	t.Run("should allow reusing released key", func(t *testing.T) {
+		released, _, err := s.Reserve(ctx, "released", "hash")
+		assert.NilError(t, err)
+
+		err = s.Release(ctx, released)
+		assert.NilError(t, err)
+
+		_, stored, err := s.Reserve(ctx, "released", "other hash")
+		assert.NilError(t, err)
+		assert.Assert(t, stored == nil)
+	})
+
+	t.Run("should allow reclaiming abandoned key after its lease expired", func(t *testing.T) {
+		leased := IdempotencyStore{Ent: client, Lease: time.Nanosecond}
+
+		_, _, err := leased.Reserve(ctx, "abandoned", "hash")
+		assert.NilError(t, err)
+
+		time.Sleep(time.Millisecond)
+
+		_, stored, err := leased.Reserve(ctx, "abandoned", "hash")
+		assert.NilError(t, err)
+		assert.Assert(t, stored == nil)
+	})
+
+	t.Run("should not complete or release key reclaimed by another request", func(t *testing.T) {
+		leased := IdempotencyStore{Ent: client, Lease: time.Nanosecond}
+
+		slow, _, err := leased.Reserve(ctx, "reclaimed", "hash")
+		assert.NilError(t, err)
+
+		time.Sleep(time.Millisecond)
+
+		// the retry reclaims the key after the lease of the slow original request expired
+		retry, _, err := s.Reserve(ctx, "reclaimed", "hash")
+		assert.NilError(t, err)
+
+		err = leased.Complete(ctx, slow, IdempotentResponse{Status: http.StatusCreated, Body: []byte(`{"id":"slow"}`)})
+		assert.Equal(t, ErrIdempotencyLeaseLost, err)
+
+		err = leased.Release(ctx, slow)
+		assert.Equal(t, ErrIdempotencyLeaseLost, err)
+
+		_, _, err = s.Reserve(ctx, "reclaimed", "hash")
+		assertHttpStatus(t, err, http.StatusConflict)
+
+		err = s.Complete(ctx, retry, IdempotentResponse{Status: http.StatusCreated, Body: []byte(`{"id":"retry"}`)})
+		assert.NilError(t, err)
+
+		_, stored, err := s.Reserve(ctx, "reclaimed", "hash")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &IdempotentResponse{Status: http.StatusCreated, Body: []byte(`{"id":"retry"}`)}, stored)
+	})
+
+	t.Run("should allow reusing completed key after its ttl expired", func(t *testing.T) {
+		expiring := IdempotencyStore{Ent: client, TTL: time.Nanosecond}
+
+		expired, _, err := expiring.Reserve(ctx, "expired", "hash")
+		assert.NilError(t, err)
+
+		err = expiring.Complete(ctx, expired, IdempotentResponse{Status: http.StatusCreated, Body: []byte(`{"id":"1"}`)})
+		assert.NilError(t, err)
+
+		time.Sleep(time.Millisecond)
+
+		_, stored, err := expiring.Reserve(ctx, "expired", "other hash")
+		assert.NilError(t, err)
+		assert.Assert(t, stored == nil)
+	})
+
+	t.Run("should delete expired keys only", func(t *testing.T) {
+		leased := IdempotencyStore{Ent: client, Lease: time.Nanosecond}
+
+		_, _, err := leased.Reserve(ctx, "expiring", "hash")
+		assert.NilError(t, err)
+
+		time.Sleep(time.Millisecond)
+
+		_, err = s.DeleteExpired(ctx)
+		assert.NilError(t, err)
+
+		exists, err := client.IdempotencyKey.Query().Where(idempotencykey.Key("expiring")).Exist(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, !exists)
+
+		// the completed key is kept for the default TTL
+		exists, err = client.IdempotencyKey.Query().Where(idempotencykey.Key("key")).Exist(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, exists)
+	})
+}
+
+func TestHashRequest(t *testing.T) {
+	first, err := HashRequest(types.CreateNewPurchaseTransaction{Amount: ptr("10"), Description: "foo"})
+	assert.NilError(t, err)
+
+	same, err := HashRequest(types.CreateNewPurchaseTransaction{Description: "foo", Amount: ptr("10")})
+	assert.NilError(t, err)
+
+	different, err := HashRequest(types.CreateNewPurchaseTransaction{Amount: ptr("10.1"), Description: "foo"})
+	assert.NilError(t, err)
+
+	assert.Equal(t, first, same)
+	assert.Assert(t, first != different)
+}
+
+func assertHttpStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	aerr, ok := err.(*apiout.APIError)
+	if !ok {
+		t.Fatalf("want api error with status %d got %v", status, err)
+	}
+
+	assert.Equal(t, status, aerr.GetHttpStatus())
+}
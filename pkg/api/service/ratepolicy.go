@@ -0,0 +1,201 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+)
+
+// RateSelectionMode is the rule by which the exchange rate of a purchase is selected among the recorded rates.
+type RateSelectionMode string
+
+const (
+	// LatestOnOrBefore selects the latest rate recorded on or before the purchase date within the lookback window.
+	LatestOnOrBefore RateSelectionMode = "latest_on_or_before"
+	// Nearest selects the rate recorded nearest to the purchase day on either side within the lookback window, the
+	// earlier rate wins a tie.
+	Nearest RateSelectionMode = "nearest"
+	// SameQuarter selects the latest rate recorded within the calendar quarter of the purchase date.
+	SameQuarter RateSelectionMode = "same_quarter"
+	// EarliestAfter selects the earliest rate recorded on or after the purchase day within the lookback window.
+	EarliestAfter RateSelectionMode = "earliest_after"
+)
+
+// DefaultRateLookbackMonths is the lookback window of the rate selection policy when not configured.
+const DefaultRateLookbackMonths = 6
+
+// RateSelectionModes lists every supported mode.
+var RateSelectionModes = []RateSelectionMode{LatestOnOrBefore, Nearest, SameQuarter, EarliestAfter}
+
+// ParseRateSelectionMode will return the mode of the given name, which is matched case insensitively.
+func ParseRateSelectionMode(name string) (RateSelectionMode, error) {
+	mode := RateSelectionMode(strings.ToLower(strings.TrimSpace(name)))
+	if !slices.Contains(RateSelectionModes, mode) {
+		return "", fmt.Errorf("unknown rate selection policy '%s'", name)
+	}
+
+	return mode, nil
+}
+
+// RateSelectionPolicy selects the exchange rate of a purchase among the rates recorded around the purchase date.
+// Zero values fall back to the defaults, which is the latest rate on or before the purchase date within the last 6 months.
+type RateSelectionPolicy struct {
+	Mode RateSelectionMode
+	// LookbackMonths bounds how far from the purchase date a rate may be recorded, except for SameQuarter
+	LookbackMonths int
+}
+
+// GetMode will return the mode of the policy, which defaults to LatestOnOrBefore.
+func (p RateSelectionPolicy) GetMode() RateSelectionMode {
+	if p.Mode == "" {
+		return LatestOnOrBefore
+	}
+
+	return p.Mode
+}
+
+func (p RateSelectionPolicy) getLookbackMonths() int {
+	if p.LookbackMonths <= 0 {
+		return DefaultRateLookbackMonths
+	}
+
+	return p.LookbackMonths
+}
+
+// Window will return the range of record dates, both inclusive, from which the policy may select the rate of a purchase made on the date.
+func (p RateSelectionPolicy) Window(purchaseDate time.Time) (time.Time, time.Time) {
+	lookback := p.getLookbackMonths()
+
+	switch p.GetMode() {
+	case Nearest:
+		day := getDay(purchaseDate)
+		return day.AddDate(0, -lookback, 0), day.AddDate(0, lookback, 0)
+	case SameQuarter:
+		quarterStart := getQuarterStart(purchaseDate)
+		return quarterStart, quarterStart.AddDate(0, 3, -1)
+	case EarliestAfter:
+		day := getDay(purchaseDate)
+		return day, day.AddDate(0, lookback, 0)
+	default:
+		day := getDay(purchaseDate)
+		return day.AddDate(0, -lookback, 0), day
+	}
+}
+
+// InWindow reports whether the rate recorded on the date may be selected for a purchase made on the purchase date.
+func (p RateSelectionPolicy) InWindow(purchaseDate, recordDate time.Time) bool {
+	from, to := p.Window(purchaseDate)
+
+	return !recordDate.Before(from) && !recordDate.After(to)
+}
+
+// Select will return the index of the record date the policy selects for the purchase date, or -1 when none of them is
+// within the window. Record dates may be in any order.
+func (p RateSelectionPolicy) Select(purchaseDate time.Time, recordDates []time.Time) int {
+	selected := -1
+
+	for i, recordDate := range recordDates {
+		if !p.InWindow(purchaseDate, recordDate) {
+			continue
+		}
+
+		if selected < 0 || p.prefers(purchaseDate, recordDate, recordDates[selected]) {
+			selected = i
+		}
+	}
+
+	return selected
+}
+
+// prefers reports whether the policy prefers the rate recorded on a over the one recorded on b.
+func (p RateSelectionPolicy) prefers(purchaseDate, a, b time.Time) bool {
+	switch p.GetMode() {
+	case Nearest:
+		day := getDay(purchaseDate)
+		distanceA, distanceB := absDuration(a.Sub(day)), absDuration(b.Sub(day))
+		if distanceA == distanceB {
+			return a.Before(b)
+		}
+
+		return distanceA < distanceB
+	case EarliestAfter:
+		return a.Before(b)
+	default:
+		return a.After(b)
+	}
+}
+
+// NotFoundError will return the error reported when there is no rate the policy may select.
+func (p RateSelectionPolicy) NotFoundError() error {
+	if p.GetMode() == LatestOnOrBefore && p.getLookbackMonths() == DefaultRateLookbackMonths {
+		return errRateNotFound
+	}
+
+	return apiout.NewCodedError(apiout.CodeExchangeRateNotFound, &rateNotFoundError{policy: p})
+}
+
+// String describes the policy e.g. 'latest_on_or_before' or 'nearest within 3 months'.
+func (p RateSelectionPolicy) String() string {
+	if p.GetMode() == SameQuarter || p.getLookbackMonths() == DefaultRateLookbackMonths {
+		return string(p.GetMode())
+	}
+
+	return fmt.Sprintf("%s within %d months", p.GetMode(), p.getLookbackMonths())
+}
+
+// rateNotFoundError is wrapped by the errors reported when there is no rate the policy may select.
+type rateNotFoundError struct {
+	policy RateSelectionPolicy
+}
+
+func (e *rateNotFoundError) Error() string {
+	lookback := e.policy.getLookbackMonths()
+
+	var reason string
+
+	switch e.policy.GetMode() {
+	case Nearest:
+		reason = fmt.Sprintf("within %d months before or after the purchase date", lookback)
+	case SameQuarter:
+		reason = "within the quarter of the purchase date"
+	case EarliestAfter:
+		reason = fmt.Sprintf("within %d months after the purchase date", lookback)
+	default:
+		reason = fmt.Sprintf("within last %d months", lookback)
+	}
+
+	return "the purchase cannot be converted to the target currency, unable to find currency converson rate " + reason
+}
+
+// isRateNotFound reports whether the error is reported when there is no rate the policy may select.
+func isRateNotFound(err error) bool {
+	var notFound *rateNotFoundError
+
+	return errors.Is(err, errRateNotFound) || errors.As(err, &notFound)
+}
+
+// getDay will return the start of the day of the date.
+func getDay(d time.Time) time.Time {
+	year, month, day := d.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, d.Location())
+}
+
+// getQuarterStart will return the first day of the calendar quarter of the date.
+func getQuarterStart(d time.Time) time.Time {
+	year, month, _ := d.Date()
+
+	return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, d.Location())
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"gotest.tools/assert"
+)
+
+func TestParseRateSelectionMode(t *testing.T) {
+	tests := []struct {
+		given   string
+		want    RateSelectionMode
+		wantErr bool
+	}{
+		{given: "latest_on_or_before", want: LatestOnOrBefore},
+		{given: " Nearest ", want: Nearest},
+		{given: "SAME_QUARTER", want: SameQuarter},
+		{given: "earliest_after", want: EarliestAfter},
+		{given: "closest", wantErr: true},
+		{given: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.given, func(t *testing.T) {
+			got, err := ParseRateSelectionMode(tt.given)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRateSelectionPolicyWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   RateSelectionPolicy
+		given    string
+		wantFrom string
+		wantTo   string
+	}{
+		{
+			name:     "should successfully decrement year",
+			given:    "2023-06-30",
+			wantFrom: "2022-12-30",
+			wantTo:   "2023-06-30",
+		},
+		{
+			name:     "should correctly return 6 months before",
+			given:    "2023-02-28",
+			wantFrom: "2022-08-28",
+			wantTo:   "2023-02-28",
+		},
+		{
+			name:     "should look back the configured months",
+			policy:   RateSelectionPolicy{Mode: LatestOnOrBefore, LookbackMonths: 3},
+			given:    "2023-02-28",
+			wantFrom: "2022-11-28",
+			wantTo:   "2023-02-28",
+		},
+		{
+			name:     "should look either side for nearest",
+			policy:   RateSelectionPolicy{Mode: Nearest, LookbackMonths: 2},
+			given:    "2023-02-28",
+			wantFrom: "2022-12-28",
+			wantTo:   "2023-04-28",
+		},
+		{
+			name:     "should span the quarter of the purchase date for same quarter",
+			policy:   RateSelectionPolicy{Mode: SameQuarter, LookbackMonths: 1},
+			given:    "2023-05-15",
+			wantFrom: "2023-04-01",
+			wantTo:   "2023-06-30",
+		},
+		{
+			name:     "should look ahead for earliest after",
+			policy:   RateSelectionPolicy{Mode: EarliestAfter},
+			given:    "2023-02-28",
+			wantFrom: "2023-02-28",
+			wantTo:   "2023-08-28",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := tt.policy.Window(mustParseDate(t, tt.given))
+
+			assert.Equal(t, tt.wantFrom, from.Format(time.DateOnly))
+			assert.Equal(t, tt.wantTo, to.Format(time.DateOnly))
+		})
+	}
+}
+
+func TestRateSelectionPolicyInWindowTimeOfDay(t *testing.T) {
+	// the time of day of the purchase should not move the window boundaries, regardless of the mode
+	purchaseDate := time.Date(2023, 6, 30, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		policy     RateSelectionPolicy
+		recordDate string
+		want       bool
+	}{
+		{name: "should include first day of lookback", recordDate: "2022-12-30", want: true},
+		{name: "should exclude day before lookback", recordDate: "2022-12-29", want: false},
+		{name: "should include purchase day", recordDate: "2023-06-30", want: true},
+		{name: "should include first day of lookback for nearest", policy: RateSelectionPolicy{Mode: Nearest}, recordDate: "2022-12-30", want: true},
+		{name: "should exclude day before lookback for nearest", policy: RateSelectionPolicy{Mode: Nearest}, recordDate: "2022-12-29", want: false},
+		{name: "should include purchase day for earliest after", policy: RateSelectionPolicy{Mode: EarliestAfter}, recordDate: "2023-06-30", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.InWindow(purchaseDate, mustParseDate(t, tt.recordDate)))
+		})
+	}
+}
+
+func TestRateSelectionPolicySelect(t *testing.T) {
+	recordDates := []time.Time{
+		mustParseDate(t, "2022-12-31"),
+		mustParseDate(t, "2022-09-30"),
+		mustParseDate(t, "2023-03-31"),
+		mustParseDate(t, "2022-06-30"),
+	}
+
+	tests := []struct {
+		name         string
+		policy       RateSelectionPolicy
+		purchaseDate string
+		want         string
+	}{
+		{name: "should select latest on or before the purchase date", purchaseDate: "2023-02-15", want: "2022-12-31"},
+		{name: "should select rate recorded on the purchase date", purchaseDate: "2022-12-31", want: "2022-12-31"},
+		{name: "should not select rate older than lookback", policy: RateSelectionPolicy{LookbackMonths: 1}, purchaseDate: "2023-02-15"},
+		{name: "should select nearest after the purchase date", policy: RateSelectionPolicy{Mode: Nearest}, purchaseDate: "2023-03-01", want: "2023-03-31"},
+		{name: "should select nearest before the purchase date", policy: RateSelectionPolicy{Mode: Nearest}, purchaseDate: "2023-01-15", want: "2022-12-31"},
+		{name: "should select earlier rate on a tie", policy: RateSelectionPolicy{Mode: Nearest}, purchaseDate: "2022-11-15", want: "2022-09-30"},
+		{name: "should select latest of the quarter", policy: RateSelectionPolicy{Mode: SameQuarter}, purchaseDate: "2022-10-01", want: "2022-12-31"},
+		{name: "should not select rate of another quarter", policy: RateSelectionPolicy{Mode: SameQuarter}, purchaseDate: "2023-04-01"},
+		{name: "should select earliest after the purchase date", policy: RateSelectionPolicy{Mode: EarliestAfter}, purchaseDate: "2022-07-01", want: "2022-09-30"},
+		{name: "should not select rate beyond lookback after the purchase date", policy: RateSelectionPolicy{Mode: EarliestAfter, LookbackMonths: 1}, purchaseDate: "2023-04-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.Select(mustParseDate(t, tt.purchaseDate), recordDates)
+			if tt.want == "" {
+				assert.Equal(t, -1, got)
+				return
+			}
+
+			assert.Assert(t, got >= 0)
+			assert.Equal(t, tt.want, recordDates[got].Format(time.DateOnly))
+		})
+	}
+}
+
+func TestRateSelectionPolicyNotFoundError(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RateSelectionPolicy
+		wantErr string
+	}{
+		{
+			name:    "should keep the error of the default policy",
+			wantErr: errRateNotFound.Error(),
+		},
+		{
+			name:    "should report the lookback window",
+			policy:  RateSelectionPolicy{LookbackMonths: 3},
+			wantErr: "the purchase cannot be converted to the target currency, unable to find currency converson rate within last 3 months",
+		},
+		{
+			name:    "should report the quarter",
+			policy:  RateSelectionPolicy{Mode: SameQuarter},
+			wantErr: "the purchase cannot be converted to the target currency, unable to find currency converson rate within the quarter of the purchase date",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.NotFoundError()
+
+			var aerr *apiout.APIError
+			assert.Assert(t, errors.As(err, &aerr))
+			assert.Equal(t, apiout.CodeExchangeRateNotFound, aerr.GetCode())
+			assert.Equal(t, tt.wantErr, err.Error())
+			assert.Assert(t, IsOutsideRateWindow(err))
+		})
+	}
+
+	assert.Assert(t, !IsOutsideRateWindow(errors.New("service unavailable")))
+}
@@ -2,73 +2,384 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log/slog"
-	"net/http"
+	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/ent/predicate"
 	"github.com/eddie023/wex-tag/ent/transaction"
 	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/currency"
+	"github.com/eddie023/wex-tag/pkg/money"
+	"github.com/eddie023/wex-tag/pkg/tracing"
 	"github.com/eddie023/wex-tag/pkg/types"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 )
 
+// usdCurrencyCode is the ISO 4217 code of the currency purchases are stored in.
+const usdCurrencyCode = "USD"
+
 type Service struct {
 	Ent *ent.Client
+
+	// ExchangeRates looks up the exchange rate purchases made in a currency other than USD are converted to USD with
+	ExchangeRates exchangeRateGetter
+	// RoundingMode rounds the original amount to the minor units of its currency and the amount in USD to cents
+	RoundingMode money.RoundingMode
+}
+
+// originalPurchase is the amount of a purchase made in a currency other than USD along with the exchange rate it was converted to USD with.
+type originalPurchase struct {
+	amount   money.Money
+	rate     decimal.Decimal
+	rateDate time.Time
 }
 
 // CreatePurchase will store the request payload into database and return a new purchase transaction.
 func (s *Service) CreateNewPurchaseTransaction(ctx context.Context, payload types.CreateNewPurchaseTransaction) (types.Transaction, error) {
-	slog.Info("creating new purchase transaction", "amount", payload.Amount)
+	ctx, span := tracing.Tracer().Start(ctx, "Service.CreateNewPurchaseTransaction")
+	defer span.End()
+
+	slog.InfoContext(ctx, "creating new purchase transaction", "amount", stringValue(payload.Amount), "original_amount", stringValue(payload.OriginalAmount), "original_currency", stringValue(payload.OriginalCurrency))
+
+	transactionDate, err := getTransactionDate(payload.TransactionDate, time.Now().UTC())
+	if err != nil {
+		return types.Transaction{}, err
+	}
 
-	amount, err := decimal.NewFromString(payload.Amount)
+	amount, original, err := s.getPurchaseAmount(ctx, payload, transactionDate)
 	if err != nil {
-		return types.Transaction{}, apiout.BadRequest(fmt.Sprintf("unable to parse provided amount '%s'", payload.Amount))
+		return types.Transaction{}, err
+	}
+
+	amountInUSD := amount.Round(s.RoundingMode)
+
+	create := s.Ent.Transaction.Create().SetAmountInUsd(amountInUSD.Amount).SetDate(transactionDate).SetDescription(payload.Description)
+	if original != nil {
+		create.SetOriginalAmount(original.amount.Amount).
+			SetOriginalCurrency(original.amount.Currency).
+			SetExchangeRate(original.rate).
+			SetExchangeRateDate(original.rateDate)
+	}
+
+	transaction, err := create.Save(ctx)
+	if err != nil {
+		return types.Transaction{}, err
+	}
+
+	slog.InfoContext(ctx, "successfully processed new purchase transaction", "transaction_id", transaction.ID)
+
+	return GetTransactionDetails(transaction), nil
+}
+
+// getPurchaseAmount will return the purchase amount in USD, which is either given as is or converted from the original amount
+// with the exchange rate of the purchase date. Purchases made in USD have no original purchase.
+func (s *Service) getPurchaseAmount(ctx context.Context, payload types.CreateNewPurchaseTransaction, transactionDate time.Time) (money.Money, *originalPurchase, error) {
+	switch {
+	case payload.OriginalAmount == nil && payload.OriginalCurrency == nil:
+		if payload.Amount == nil {
+			return money.Money{}, nil, apiout.NewFieldError(apiout.CodeInvalidAmount, "amount", errors.New("either amount or originalAmount and originalCurrency must be provided"))
+		}
+
+		amount, err := parseAmount("amount", *payload.Amount)
+
+		return money.New(amount, usdCurrencyCode), nil, err
+	case payload.Amount != nil:
+		return money.Money{}, nil, apiout.NewFieldError(apiout.CodeInvalidAmount, "amount", errors.New("amount must not be combined with originalAmount and originalCurrency"))
+	case payload.OriginalAmount == nil:
+		return money.Money{}, nil, apiout.NewFieldError(apiout.CodeInvalidAmount, "originalAmount", errors.New("originalAmount must be provided along with originalCurrency"))
+	case payload.OriginalCurrency == nil:
+		return money.Money{}, nil, apiout.NewFieldError(apiout.CodeInvalidCurrency, "originalCurrency", errors.New("originalCurrency must be provided along with originalAmount"))
+	}
+
+	parsed, err := parseAmount("originalAmount", *payload.OriginalAmount)
+	if err != nil {
+		return money.Money{}, nil, err
+	}
+
+	// the treasury only quotes rates against USD, thus a purchase made in USD needs no conversion
+	currencyCode := strings.ToUpper(strings.TrimSpace(*payload.OriginalCurrency))
+	if currencyCode == usdCurrencyCode {
+		return money.New(parsed, usdCurrencyCode), nil, nil
+	}
+
+	d, err := currency.Resolve("", currencyCode)
+	if err != nil {
+		return money.Money{}, nil, apiout.NewFieldError(apiout.CodeInvalidCurrency, "originalCurrency", err)
+	}
+
+	// the original amount is stored and converted in the minor units of its currency, e.g. whole yen, the same way the
+	// amount in USD is rounded to cents
+	originalAmount := money.New(parsed, d.CurrencyCode).Round(s.RoundingMode)
+
+	if s.ExchangeRates == nil {
+		return money.Money{}, nil, errors.New("exchange rates are not configured, unable to convert purchase to USD")
+	}
+
+	er, err := s.ExchangeRates.GetExchangeRate(ctx, ExchangeRatePayload{CountryName: d.Country, Currency: d.Currency, RecordDate: transactionDate})
+	if err != nil {
+		return money.Money{}, nil, err
+	}
+
+	rate, err := decimal.NewFromString(er.ExchangeRate)
+	if err != nil {
+		return money.Money{}, nil, errors.WithMessagef(err, "unable to parse exchange rate '%s'", er.ExchangeRate)
+	}
+
+	if !rate.IsPositive() {
+		return money.Money{}, nil, fmt.Errorf("invalid exchange rate '%s' of '%s'", er.ExchangeRate, er.CountryCurrencyDesc)
+	}
+
+	rateDate, err := time.Parse(time.DateOnly, er.RecordDate)
+	if err != nil {
+		return money.Money{}, nil, errors.WithMessagef(err, "unable to parse exchange rate record date '%s'", er.RecordDate)
+	}
+
+	original := &originalPurchase{
+		amount:   originalAmount,
+		rate:     rate,
+		rateDate: rateDate,
+	}
+
+	// treasury rates are the units of the currency worth one USD
+	return money.New(originalAmount.Amount.Div(rate), usdCurrencyCode), original, nil
+}
+
+// parseAmount will parse the amount of the given request field, which must not be negative.
+func parseAmount(field, given string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(given)
+	if err != nil {
+		return decimal.Decimal{}, apiout.NewFieldError(apiout.CodeInvalidAmount, field, fmt.Errorf("unable to parse provided %s '%s'", field, given))
 	}
 
 	// we are passing amount type as string for precision. Thus, we need to check for case
 	// such as when user passes negative integer
 	if amount.IsNegative() && !amount.IsZero() {
-		return types.Transaction{}, apiout.BadRequest("amount cannot be negative number")
+		return decimal.Decimal{}, apiout.NewFieldError(apiout.CodeInvalidAmount, field, fmt.Errorf("%s cannot be negative number", field))
 	}
 
-	roundedAmount := RoundToNearestCent(amount)
-	transaction, err := s.Ent.Transaction.Create().SetAmountInUsd(roundedAmount).SetDate(time.Now().UTC()).SetDescription(payload.Description).Save(ctx)
-	if err != nil {
-		return types.Transaction{}, err
+	return amount, nil
+}
+
+// GetTransactionDetails will return the API representation of the stored purchase transaction.
+func GetTransactionDetails(trans *ent.Transaction) types.Transaction {
+	details := types.Transaction{
+		AmountInUSD: trans.AmountInUsd.String(),
+		Date:        trans.Date,
+		Description: trans.Description,
+		Id:          trans.ID.String(),
 	}
 
-	slog.Info("successfully processed new purchase transaction", "transaction_id", transaction.ID)
+	if trans.OriginalAmount != nil {
+		details.OriginalAmount = ptr(trans.OriginalAmount.String())
+	}
+
+	if trans.OriginalCurrency != nil {
+		details.OriginalCurrency = ptr(*trans.OriginalCurrency)
+	}
 
-	return types.Transaction{
-		AmountInUSD: roundedAmount.String(),
-		Date:        transaction.Date.UTC(),
-		Description: transaction.Description,
-		Id:          transaction.ID.String(),
-	}, nil
+	if trans.ExchangeRate != nil {
+		details.OriginalExchangeRate = ptr(trans.ExchangeRate.String())
+	}
+
+	if trans.ExchangeRateDate != nil {
+		details.OriginalExchangeRateDate = ptr(trans.ExchangeRateDate.Format(time.DateOnly))
+	}
+
+	return details
 }
 
 // GetPurchaseDetailsByTransactionId will query the database to see if the purchase order with provided transaction id exist.
 func (s *Service) GetPurchaseDetailsByTransactionId(ctx context.Context, id uuid.UUID) (*ent.Transaction, error) {
-	slog.Info("fetching transaction details", "transaction_id", id)
+	ctx, span := tracing.Tracer().Start(ctx, "Service.GetPurchaseDetailsByTransactionId")
+	defer span.End()
+
+	slog.InfoContext(ctx, "fetching transaction details", "transaction_id", id)
 
 	transaction, err := s.Ent.Transaction.Query().Where(transaction.ID(id)).First(ctx)
 	if err != nil {
-		return nil, apiout.NewRequestError(errors.New("given transaction id not found"), http.StatusNotFound)
+		return nil, apiout.NewCodedError(apiout.CodeTransactionNotFound, errors.New("given transaction id not found"))
 	}
 
 	return transaction, nil
 }
 
+// GetPurchaseDetailsByTransactionIds will query the database for the purchase orders with provided transaction ids in a single query.
+// Transaction ids which do not exist are not part of the result.
+func (s *Service) GetPurchaseDetailsByTransactionIds(ctx context.Context, ids []uuid.UUID) ([]*ent.Transaction, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.GetPurchaseDetailsByTransactionIds")
+	defer span.End()
+
+	slog.InfoContext(ctx, "fetching transaction details", "transactions", len(ids))
+
+	transactions, err := s.Ent.Transaction.Query().Where(transaction.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "unable to fetch transactions")
+	}
+
+	return transactions, nil
+}
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// ListPurchaseTransactions will return a page of stored purchase transactions matching the provided filters along with the cursor for the next page.
+// Transactions are ordered by (date, id) in descending order such that the newest purchase comes first and the order is stable for transactions with same date.
+func (s *Service) ListPurchaseTransactions(ctx context.Context, params types.ListPurchaseTransactionsParams) ([]*ent.Transaction, *string, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.ListPurchaseTransactions")
+	defer span.End()
+
+	limit := defaultListLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	if limit < 1 || limit > maxListLimit {
+		return nil, nil, apiout.NewFieldError(apiout.CodeInvalidQueryParameter, "limit", fmt.Errorf("limit must be between 1 and %d", maxListLimit))
+	}
+
+	predicates, err := getListPredicates(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	slog.InfoContext(ctx, "listing purchase transactions", "limit", limit, "has_cursor", params.Cursor != nil)
+
+	// fetch one extra row to find out if there is a next page
+	transactions, err := s.Ent.Transaction.Query().
+		Where(predicates...).
+		Order(transaction.ByDate(sql.OrderDesc()), transaction.ByID(sql.OrderDesc())).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(transactions) <= limit {
+		return transactions, nil, nil
+	}
+
+	transactions = transactions[:limit]
+	nextCursor := encodeCursor(transactions[limit-1])
+
+	return transactions, &nextCursor, nil
+}
+
+// getListPredicates will convert the provided list filters to ent predicates.
+func getListPredicates(params types.ListPurchaseTransactionsParams) ([]predicate.Transaction, error) {
+	var predicates []predicate.Transaction
+
+	if params.Cursor != nil {
+		date, id, err := decodeCursor(*params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		// continue right after the last transaction of previous page
+		predicates = append(predicates, transaction.Or(
+			transaction.DateLT(date),
+			transaction.And(transaction.DateEQ(date), transaction.IDLT(id)),
+		))
+	}
+
+	if params.FromDate != nil {
+		predicates = append(predicates, transaction.DateGTE(params.FromDate.UTC()))
+	}
+
+	if params.ToDate != nil {
+		predicates = append(predicates, transaction.DateLTE(params.ToDate.UTC()))
+	}
+
+	if params.MinAmount != nil {
+		minAmount, err := decimal.NewFromString(*params.MinAmount)
+		if err != nil {
+			return nil, apiout.NewFieldError(apiout.CodeInvalidQueryParameter, "minAmount", fmt.Errorf("unable to parse provided minAmount '%s'", *params.MinAmount))
+		}
+
+		predicates = append(predicates, transaction.AmountInUsdGTE(minAmount))
+	}
+
+	if params.MaxAmount != nil {
+		maxAmount, err := decimal.NewFromString(*params.MaxAmount)
+		if err != nil {
+			return nil, apiout.NewFieldError(apiout.CodeInvalidQueryParameter, "maxAmount", fmt.Errorf("unable to parse provided maxAmount '%s'", *params.MaxAmount))
+		}
+
+		predicates = append(predicates, transaction.AmountInUsdLTE(maxAmount))
+	}
+
+	if params.Description != nil && *params.Description != "" {
+		predicates = append(predicates, transaction.DescriptionContainsFold(*params.Description))
+	}
+
+	return predicates, nil
+}
+
+// encodeCursor will generate an opaque cursor pointing to the given transaction.
+func encodeCursor(t *ent.Transaction) string {
+	raw := fmt.Sprintf("%s,%s", t.Date.UTC().Format(time.RFC3339Nano), t.ID.String())
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor will parse the cursor generated by encodeCursor back to transaction date and id.
+func decodeCursor(cursor string) (time.Time, uuid.UUID, error) {
+	invalidCursorErr := apiout.NewFieldError(apiout.CodeInvalidCursor, "cursor", errors.New("invalid cursor provided"))
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, invalidCursorErr
+	}
+
+	date, id, found := strings.Cut(string(raw), ",")
+	if !found {
+		return time.Time{}, uuid.UUID{}, invalidCursorErr
+	}
+
+	parsedDate, err := time.Parse(time.RFC3339Nano, date)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, invalidCursorErr
+	}
+
+	parsedId, err := uuid.Parse(id)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, invalidCursorErr
+	}
+
+	return parsedDate.UTC(), parsedId, nil
+}
+
+// getTransactionDate will return the client supplied purchase date in UTC or fallback to now when it is not provided.
+// A purchase cannot happen in the future, hence any date after now is rejected.
+func getTransactionDate(given *time.Time, now time.Time) (time.Time, error) {
+	if given == nil {
+		return now, nil
+	}
+
+	if given.After(now) {
+		return time.Time{}, apiout.NewFieldError(apiout.CodeInvalidTransactionDate, "transactionDate", errors.New("transaction date cannot be in the future"))
+	}
+
+	return given.UTC(), nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
 // ParseStringToUUID will try to parse the provided string to UUID
 func ParseStringToUUID(s string) (uuid.UUID, error) {
 	return uuid.Parse(s)
 }
-
-// RoundToNearestCent will round the given decimal number to nearest cent.
-func RoundToNearestCent(n decimal.Decimal) decimal.Decimal {
-	return n.Round(2)
-}
@@ -3,13 +3,18 @@ package service
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/ent/transaction"
+	"github.com/eddie023/wex-tag/pkg/apiout"
 	"github.com/eddie023/wex-tag/pkg/db"
+	"github.com/eddie023/wex-tag/pkg/money"
 	"github.com/eddie023/wex-tag/pkg/types"
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 	"gotest.tools/assert"
 )
 
@@ -21,11 +26,14 @@ func TestCreatePurchaseTransaction(t *testing.T) {
 		want    string
 	}
 
+	pastDate := time.Now().UTC().AddDate(0, -1, 0)
+	futureDate := time.Now().UTC().AddDate(0, 0, 1)
+
 	testcases := []testcase{
 		{
 			name: "should correctly create new transaction for valid positive amounts",
 			payload: types.CreateNewPurchaseTransaction{
-				Amount:      "123.16",
+				Amount:      ptr("123.16"),
 				Description: "Positive amount",
 			},
 			wantErr: nil,
@@ -34,7 +42,7 @@ func TestCreatePurchaseTransaction(t *testing.T) {
 		{
 			name: "should correctly create new transaction for zero amount",
 			payload: types.CreateNewPurchaseTransaction{
-				Amount:      "0",
+				Amount:      ptr("0"),
 				Description: "Positive amount",
 			},
 			wantErr: nil,
@@ -43,7 +51,7 @@ func TestCreatePurchaseTransaction(t *testing.T) {
 		{
 			name: "should fail for negative amount value",
 			payload: types.CreateNewPurchaseTransaction{
-				Amount:      "-123.123",
+				Amount:      ptr("-123.123"),
 				Description: "This is negative amount",
 			},
 			wantErr: errors.New("amount cannot be negative number"),
@@ -52,12 +60,32 @@ func TestCreatePurchaseTransaction(t *testing.T) {
 		{
 			name: "should fail for invalid amount value",
 			payload: types.CreateNewPurchaseTransaction{
-				Amount:      "-123.123abcd",
+				Amount:      ptr("-123.123abcd"),
 				Description: "Invalid amount",
 			},
 			wantErr: errors.New("unable to parse provided amount"),
 			want:    "",
 		},
+		{
+			name: "should correctly create new transaction for past transaction date",
+			payload: types.CreateNewPurchaseTransaction{
+				Amount:          ptr("10.129"),
+				Description:     "Past purchase",
+				TransactionDate: &pastDate,
+			},
+			wantErr: nil,
+			want:    "10.13",
+		},
+		{
+			name: "should fail for transaction date in the future",
+			payload: types.CreateNewPurchaseTransaction{
+				Amount:          ptr("10"),
+				Description:     "Future purchase",
+				TransactionDate: &futureDate,
+			},
+			wantErr: errors.New("transaction date cannot be in the future"),
+			want:    "",
+		},
 	}
 
 	for _, tc := range testcases {
@@ -87,44 +115,236 @@ func TestCreatePurchaseTransaction(t *testing.T) {
 
 }
 
-// Amount should be valid positive number rounded to the nearest cent
-func TestRoundToNearestCent(t *testing.T) {
+func TestCreatePurchaseTransactionRounding(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		rounding money.RoundingMode
+		want     string
+	}{
+		{name: "should round half up by default", amount: "10.125", want: "10.13"},
+		{name: "should round half to even", amount: "10.125", rounding: money.HalfEven, want: "10.12"},
+		{name: "should round down", amount: "10.129", rounding: money.Down, want: "10.12"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ent := db.CreateTestDatabase(t)
+			defer ent.Close()
+
+			s := Service{
+				Ent:          ent,
+				RoundingMode: tt.rounding,
+			}
+
+			got, err := s.CreateNewPurchaseTransaction(context.TODO(), types.CreateNewPurchaseTransaction{Amount: ptr(tt.amount), Description: "rounded"})
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got.AmountInUSD)
+		})
+	}
+}
+
+func TestCreateForeignCurrencyPurchaseTransaction(t *testing.T) {
+	purchaseDate := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+
+	getter := &fakeExchangeRateGetter{
+		rates: map[string]ExchangeRateResponse{
+			"Euro":  {CountryCurrencyDesc: "Euro Zone-Euro", ExchangeRate: "0.918", RecordDate: "2023-09-30"},
+			"Yen":   {CountryCurrencyDesc: "Japan-Yen", ExchangeRate: "0", RecordDate: "2023-09-30"},
+			"Dinar": {CountryCurrencyDesc: "Kuwait-Dinar", ExchangeRate: "0.308", RecordDate: "2023-09-30"},
+		},
+		errs: map[string]error{
+			"Pound": errRateNotFound,
+		},
+	}
 
 	tests := []struct {
-		name  string
-		given decimal.Decimal
-		want  string
+		name        string
+		payload     types.CreateNewPurchaseTransaction
+		want        types.Transaction
+		wantErrCode apiout.ErrorCode
+		wantErr     string
 	}{
 		{
-			name:  "should not round for digit less than 5",
-			given: decimal.NewFromFloat(12.6544),
-			want:  "12.65",
+			name:    "should convert the original amount to USD with the exchange rate of the purchase date",
+			payload: types.CreateNewPurchaseTransaction{OriginalAmount: ptr("100"), OriginalCurrency: ptr("eur")},
+			want: types.Transaction{
+				AmountInUSD:              "108.93",
+				OriginalAmount:           ptr("100"),
+				OriginalCurrency:         ptr("EUR"),
+				OriginalExchangeRate:     ptr("0.918"),
+				OriginalExchangeRateDate: ptr("2023-09-30"),
+			},
+		},
+		{
+			name:    "should round the original amount to cents before converting it",
+			payload: types.CreateNewPurchaseTransaction{OriginalAmount: ptr("100.005"), OriginalCurrency: ptr("EUR")},
+			want: types.Transaction{
+				AmountInUSD:              "108.94",
+				OriginalAmount:           ptr("100.01"),
+				OriginalCurrency:         ptr("EUR"),
+				OriginalExchangeRate:     ptr("0.918"),
+				OriginalExchangeRateDate: ptr("2023-09-30"),
+			},
+		},
+		{
+			name:    "should round the original amount to the minor units of its currency",
+			payload: types.CreateNewPurchaseTransaction{OriginalAmount: ptr("100.12345"), OriginalCurrency: ptr("KWD")},
+			want: types.Transaction{
+				AmountInUSD:              "325.07",
+				OriginalAmount:           ptr("100.123"),
+				OriginalCurrency:         ptr("KWD"),
+				OriginalExchangeRate:     ptr("0.308"),
+				OriginalExchangeRateDate: ptr("2023-09-30"),
+			},
+		},
+		{
+			name:    "should store purchase in USD as is",
+			payload: types.CreateNewPurchaseTransaction{OriginalAmount: ptr("10.129"), OriginalCurrency: ptr("USD")},
+			want:    types.Transaction{AmountInUSD: "10.13"},
+		},
+		{
+			name:        "should fail when neither amount nor original amount is provided",
+			payload:     types.CreateNewPurchaseTransaction{},
+			wantErrCode: apiout.CodeInvalidAmount,
+			wantErr:     "either amount or originalAmount and originalCurrency must be provided",
+		},
+		{
+			name:        "should fail when amount is combined with original amount",
+			payload:     types.CreateNewPurchaseTransaction{Amount: ptr("10"), OriginalAmount: ptr("100"), OriginalCurrency: ptr("EUR")},
+			wantErrCode: apiout.CodeInvalidAmount,
+			wantErr:     "amount must not be combined with originalAmount and originalCurrency",
+		},
+		{
+			name:        "should fail when original currency is missing",
+			payload:     types.CreateNewPurchaseTransaction{OriginalAmount: ptr("100")},
+			wantErrCode: apiout.CodeInvalidCurrency,
+			wantErr:     "originalCurrency must be provided along with originalAmount",
 		},
 		{
-			name:  "Should not round for zero",
-			given: decimal.NewFromFloat(0),
-			want:  "0",
+			name:        "should fail when original amount is missing",
+			payload:     types.CreateNewPurchaseTransaction{OriginalCurrency: ptr("EUR")},
+			wantErrCode: apiout.CodeInvalidAmount,
+			wantErr:     "originalAmount must be provided along with originalCurrency",
 		},
 		{
-			name:  "Should not round for digit five",
-			given: decimal.NewFromFloat(1.5),
-			want:  "1.5",
+			name:        "should fail for negative original amount",
+			payload:     types.CreateNewPurchaseTransaction{OriginalAmount: ptr("-1"), OriginalCurrency: ptr("EUR")},
+			wantErrCode: apiout.CodeInvalidAmount,
+			wantErr:     "originalAmount cannot be negative number",
 		},
 		{
-			name:  "should round for digit greater than five",
-			given: decimal.NewFromFloat(12.65766),
-			want:  "12.66",
+			name:        "should fail for unknown original currency",
+			payload:     types.CreateNewPurchaseTransaction{OriginalAmount: ptr("100"), OriginalCurrency: ptr("XYZ")},
+			wantErrCode: apiout.CodeInvalidCurrency,
+			wantErr:     "unknown currency code 'XYZ'",
+		},
+		{
+			name:        "should fail when there is no exchange rate for the purchase date",
+			payload:     types.CreateNewPurchaseTransaction{OriginalAmount: ptr("100"), OriginalCurrency: ptr("GBP")},
+			wantErrCode: apiout.CodeExchangeRateNotFound,
+			wantErr:     errRateNotFound.Error(),
+		},
+		{
+			name:    "should fail for invalid exchange rate",
+			payload: types.CreateNewPurchaseTransaction{OriginalAmount: ptr("100"), OriginalCurrency: ptr("JPY")},
+			wantErr: "invalid exchange rate '0' of 'Japan-Yen'",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := RoundToNearestCent(tt.given)
+			ent := db.CreateTestDatabase(t)
+			defer ent.Close()
+
+			s := Service{
+				Ent:           ent,
+				ExchangeRates: getter,
+			}
+
+			tt.payload.Description = "foreign purchase"
+			tt.payload.TransactionDate = &purchaseDate
+
+			got, err := s.CreateNewPurchaseTransaction(context.TODO(), tt.payload)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+
+				if tt.wantErrCode != "" {
+					var aerr *apiout.APIError
+					assert.Assert(t, errors.As(err, &aerr))
+					assert.Equal(t, tt.wantErrCode, aerr.GetCode())
+				}
+
+				return
+			}
+
+			assert.NilError(t, err)
+
+			tt.want.Id = got.Id
+			tt.want.Date = purchaseDate
+			tt.want.Description = "foreign purchase"
+			assert.DeepEqual(t, tt.want, got)
+
+			// the original purchase is returned the same once stored
+			stored, err := s.GetPurchaseDetailsByTransactionId(context.TODO(), uuid.MustParse(got.Id))
+			assert.NilError(t, err)
+
+			stored.Date = stored.Date.UTC()
+			assert.DeepEqual(t, tt.want, GetTransactionDetails(stored))
+		})
+	}
+}
 
-			assert.Equal(t, tt.want, got.String())
+func TestGetTransactionDate(t *testing.T) {
+	now, err := time.Parse(time.RFC3339, "2023-12-01T10:00:00Z")
+	if err != nil {
+		t.Fatal()
+	}
+
+	yesterday := now.AddDate(0, 0, -1)
+	tomorrow := now.AddDate(0, 0, 1)
+	nonUTC := time.Date(2023, 11, 30, 23, 30, 0, 0, time.FixedZone("NPT", 5*60*60+45*60))
+
+	tests := []struct {
+		name    string
+		given   *time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "should default to now when transaction date is not provided",
+			given: nil,
+			want:  now,
+		},
+		{
+			name:  "should return provided past transaction date",
+			given: &yesterday,
+			want:  yesterday,
+		},
+		{
+			name:  "should convert provided transaction date to UTC",
+			given: &nonUTC,
+			want:  time.Date(2023, 11, 30, 17, 45, 0, 0, time.UTC),
+		},
+		{
+			name:    "should fail for transaction date in the future",
+			given:   &tomorrow,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getTransactionDate(tt.given, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got = %s, want %s", got, tt.want)
+			}
 		})
 	}
 }
 
+// Amount should be valid positive number rounded to the nearest cent
 func TestParseStringToUUID(t *testing.T) {
 
 	tests := []struct {
@@ -173,3 +393,214 @@ func TestParseStringToUUID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPurchaseDetailsByTransactionIds(t *testing.T) {
+	ent := db.CreateTestDatabase(t)
+	defer ent.Close()
+
+	s := Service{
+		Ent: ent,
+	}
+
+	var created []uuid.UUID
+	for _, description := range []string{"first", "second", "third"} {
+		trans, err := s.CreateNewPurchaseTransaction(context.TODO(), types.CreateNewPurchaseTransaction{Amount: ptr("10"), Description: description})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		created = append(created, uuid.MustParse(trans.Id))
+	}
+
+	tests := []struct {
+		name string
+		give []uuid.UUID
+		want []string
+	}{
+		{
+			name: "should return every requested transaction",
+			give: []uuid.UUID{created[0], created[2]},
+			want: []string{created[0].String(), created[2].String()},
+		},
+		{
+			name: "should skip transaction ids which do not exist",
+			give: []uuid.UUID{created[1], uuid.New()},
+			want: []string{created[1].String()},
+		},
+		{
+			name: "should return empty result when none of the transactions exist",
+			give: []uuid.UUID{uuid.New()},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.GetPurchaseDetailsByTransactionIds(context.TODO(), tt.give)
+			assert.NilError(t, err)
+
+			gotIds := []string{}
+			for _, trans := range got {
+				gotIds = append(gotIds, trans.ID.String())
+			}
+
+			assert.DeepEqual(t, sortedStrings(tt.want), sortedStrings(gotIds))
+		})
+	}
+}
+
+func sortedStrings(s []string) []string {
+	sorted := append([]string{}, s...)
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+func TestListPurchaseTransactions(t *testing.T) {
+	ent := db.CreateTestDatabase(t)
+	defer ent.Close()
+
+	s := Service{
+		Ent: ent,
+	}
+
+	baseDate, err := time.Parse(time.DateOnly, "2023-06-30")
+	if err != nil {
+		t.Fatal()
+	}
+
+	// description of each transaction is the order in which it should be listed
+	given := []struct {
+		description string
+		amount      string
+		date        time.Time
+	}{
+		{description: "third", amount: "30", date: baseDate.AddDate(0, 0, -2)},
+		{description: "first", amount: "10", date: baseDate},
+		{description: "fifth", amount: "50.55", date: baseDate.AddDate(0, -1, 0)},
+		{description: "second", amount: "20", date: baseDate.AddDate(0, 0, -1)},
+		{description: "fourth", amount: "40", date: baseDate.AddDate(0, 0, -2)},
+	}
+
+	for _, g := range given {
+		date := g.date
+		_, err := s.CreateNewPurchaseTransaction(context.TODO(), types.CreateNewPurchaseTransaction{
+			Amount:          ptr(g.amount),
+			Description:     g.description,
+			TransactionDate: &date,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// "third" and "fourth" share the same date and are ordered by id instead
+	sameDate, err := ent.Transaction.Query().Where(transaction.DateEQ(baseDate.AddDate(0, 0, -2))).All(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sameDateOrder := []string{sameDate[0].Description, sameDate[1].Description}
+	if sameDate[0].ID.String() < sameDate[1].ID.String() {
+		sameDateOrder = []string{sameDate[1].Description, sameDate[0].Description}
+	}
+
+	t.Run("should page through all transactions in descending order", func(t *testing.T) {
+		limit := 2
+		var cursor *string
+		var got []string
+
+		for i := 0; i < 5; i++ {
+			transactions, nextCursor, err := s.ListPurchaseTransactions(context.TODO(), types.ListPurchaseTransactionsParams{
+				Limit:  &limit,
+				Cursor: cursor,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, tr := range transactions {
+				got = append(got, tr.Description)
+			}
+
+			if nextCursor == nil {
+				break
+			}
+			cursor = nextCursor
+		}
+
+		want := []string{"first", "second", sameDateOrder[0], sameDateOrder[1], "fifth"}
+		assert.DeepEqual(t, want, got)
+	})
+
+	tests := []struct {
+		name    string
+		params  types.ListPurchaseTransactionsParams
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "should filter by date range",
+			params: types.ListPurchaseTransactionsParams{FromDate: ptr(baseDate.AddDate(0, 0, -1)), ToDate: ptr(baseDate)},
+			want:   []string{"first", "second"},
+		},
+		{
+			name:   "should filter by amount range",
+			params: types.ListPurchaseTransactionsParams{MinAmount: ptr("20"), MaxAmount: ptr("30")},
+			want:   []string{"second", "third"},
+		},
+		{
+			name:   "should filter by case insensitive description",
+			params: types.ListPurchaseTransactionsParams{Description: ptr("FIF")},
+			want:   []string{"fifth"},
+		},
+		{
+			name:    "should fail for invalid amount filter",
+			params:  types.ListPurchaseTransactionsParams{MinAmount: ptr("abcd")},
+			wantErr: true,
+		},
+		{
+			name:    "should fail for invalid cursor",
+			params:  types.ListPurchaseTransactionsParams{Cursor: ptr("invalid")},
+			wantErr: true,
+		},
+		{
+			name:    "should fail for limit above maximum",
+			params:  types.ListPurchaseTransactionsParams{Limit: ptr(101)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactions, nextCursor, err := s.ListPurchaseTransactions(context.TODO(), tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			got := []string{}
+			for _, tr := range transactions {
+				got = append(got, tr.Description)
+			}
+
+			assert.DeepEqual(t, tt.want, got)
+			assert.Assert(t, nextCursor == nil)
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	date := time.Date(2023, 12, 1, 10, 58, 37, 50074000, time.UTC)
+	id := uuid.MustParse("c4c1666f-2eda-49c7-99b8-635223f1330a")
+
+	cursor := encodeCursor(&ent.Transaction{ID: id, Date: date})
+
+	gotDate, gotId, err := decodeCursor(cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Assert(t, date.Equal(gotDate))
+	assert.Equal(t, id, gotId)
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceRequests is a middleware which starts a span for every request, continuing the trace of the W3C traceparent
+// header when present. Spans are named by the OpenAPI operation id once the request has been routed.
+func traceRequests(swagger *openapi3.T) func(http.Handler) http.Handler {
+	operations := getOperationIds(swagger)
+
+	return func(next http.Handler) http.Handler {
+		named := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(getOperation(r, operations))
+
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				span.SetAttributes(attribute.String("http.route", rctx.RoutePattern()))
+			}
+		})
+
+		return otelhttp.NewHandler(named, "http.server")
+	}
+}
@@ -1,23 +1,41 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 
+	"github.com/eddie023/wex-tag/ent"
 	"github.com/eddie023/wex-tag/pkg/api/service"
 	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/eddie023/wex-tag/pkg/currency"
+	"github.com/eddie023/wex-tag/pkg/metrics"
 	"github.com/eddie023/wex-tag/pkg/types"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
-// GET /purchase/{transaction_id}?country=""&currency=""
+// GET /purchase/{transaction_id}?country=""&currency="" or ?countryCode=""&currencyCode="", optionally with &ratePolicy=""
 func (a *API) GetPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params types.GetPurchaseTransactionParams) {
 	ctx := r.Context()
 
+	mediaType, err := apiout.Negotiate(r, apiout.ResponseMediaTypes...)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
 	uuidString, err := service.ParseStringToUUID(transactionId)
 	if err != nil {
-		slog.Error("failed to parse provided transaction id", "err", err.Error())
-		apiout.Error(ctx, w, apiout.NewRequestError(errors.New("invalid transaction id provided"), http.StatusBadRequest))
+		slog.ErrorContext(ctx, "failed to parse provided transaction id", "err", err.Error())
+		apiout.Error(ctx, w, apiout.NewFieldError(apiout.CodeInvalidTransactionId, "transactionId", errors.New("invalid transaction id provided")))
+		return
+	}
+
+	target, err := getConversionTarget(params)
+	if err != nil {
+		apiout.Error(ctx, w, err)
 		return
 	}
 
@@ -28,41 +46,357 @@ func (a *API) GetPurchaseTransaction(w http.ResponseWriter, r *http.Request, tra
 	}
 
 	exchangeRateDetails, err := a.ExchangeRateService.GetExchangeRate(ctx, service.ExchangeRatePayload{
-		CountryName: params.Country,
-		Currency:    params.Currency,
+		CountryName: target.CountryName,
+		Currency:    target.Currency,
 		RecordDate:  transactionDetails.Date,
+		Policy:      getRateSelectionPolicy(params.RatePolicy),
 	})
 	if err != nil {
+		if service.IsOutsideRateWindow(err) {
+			metrics.ConversionsOutsideRateWindow.Inc()
+		}
+
 		apiout.Error(ctx, w, err)
 		return
 	}
 
-	response, err := a.ExchangeRateService.ConvertCurrency(service.ExchangeRatePayload{CountryName: params.Country, Currency: params.Currency}, transactionDetails, exchangeRateDetails)
+	response, err := a.ExchangeRateService.ConvertCurrency(ctx, target, transactionDetails, exchangeRateDetails)
 	if err != nil {
 		apiout.Error(ctx, w, err)
 		return
 	}
 
-	apiout.JSON(ctx, w, response, http.StatusOK)
+	apiout.Respond(ctx, w, mediaType, response, http.StatusOK)
+}
+
+// getConversionTarget will return the treasury country and currency to convert the purchase to, given either by name
+// or by ISO codes which are resolved through the currency mapping table.
+func getConversionTarget(params types.GetPurchaseTransactionParams) (service.ExchangeRatePayload, error) {
+	byCode := params.CountryCode != nil || params.CurrencyCode != nil
+	byName := params.Country != nil || params.Currency != nil
+
+	switch {
+	case byCode && byName:
+		return service.ExchangeRatePayload{}, apiout.NewFieldError(apiout.CodeInvalidConversionTarget, "countryCode", errors.New("country and currency must not be combined with countryCode or currencyCode"))
+	case byCode:
+		d, err := currency.Resolve(stringValue(params.CountryCode), stringValue(params.CurrencyCode))
+		if err != nil {
+			field := "currencyCode"
+			if errors.Is(err, currency.ErrUnknownCountryCode) {
+				field = "countryCode"
+			}
+
+			return service.ExchangeRatePayload{}, apiout.NewFieldError(apiout.CodeInvalidConversionTarget, field, err)
+		}
+
+		return service.ExchangeRatePayload{CountryName: d.Country, Currency: d.Currency}, nil
+	case params.Country == nil:
+		return service.ExchangeRatePayload{}, apiout.NewFieldError(apiout.CodeInvalidConversionTarget, "country", errors.New("either country and currency or countryCode and/or currencyCode must be provided"))
+	case params.Currency == nil:
+		return service.ExchangeRatePayload{}, apiout.NewFieldError(apiout.CodeInvalidConversionTarget, "currency", errors.New("country and currency must be provided together"))
+	default:
+		return service.ExchangeRatePayload{CountryName: *params.Country, Currency: *params.Currency}, nil
+	}
+}
+
+// getRateSelectionPolicy will return the requested rate selection policy, which is left to the server default when not requested.
+func getRateSelectionPolicy(ratePolicy *types.RatePolicy) service.RateSelectionPolicy {
+	if ratePolicy == nil {
+		return service.RateSelectionPolicy{}
+	}
+
+	return service.RateSelectionPolicy{Mode: service.RateSelectionMode(*ratePolicy)}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
 }
 
 // POST /purchase
-func (a *API) PostPurchaseTransaction(w http.ResponseWriter, r *http.Request) {
+func (a *API) PostPurchaseTransaction(w http.ResponseWriter, r *http.Request, params types.PostPurchaseTransactionParams) {
 	ctx := r.Context()
 
+	mediaType, err := apiout.Negotiate(r, apiout.ResponseMediaTypes...)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
 	var payload types.CreateNewPurchaseTransaction
 
-	err := apiout.DecodeJSONBody(w, r, &payload)
+	err = apiout.DecodeJSONBody(w, r, &payload)
 	if err != nil {
 		apiout.Error(ctx, w, err)
 		return
 	}
 
+	if params.IdempotencyKey != nil {
+		a.postIdempotentPurchaseTransaction(w, r, mediaType, *params.IdempotencyKey, payload)
+		return
+	}
+
 	response, err := a.TransactionService.CreateNewPurchaseTransaction(ctx, payload)
 	if err != nil {
 		apiout.Error(ctx, w, err)
 		return
 	}
 
-	apiout.JSON(ctx, w, response, http.StatusCreated)
+	apiout.Respond(ctx, w, mediaType, response, http.StatusCreated)
+}
+
+// postIdempotentPurchaseTransaction will only create a new purchase transaction for the first request made with the idempotency key.
+// Retries with the same key and payload will get the original response replayed.
+func (a *API) postIdempotentPurchaseTransaction(w http.ResponseWriter, r *http.Request, mediaType string, key string, payload types.CreateNewPurchaseTransaction) {
+	ctx := r.Context()
+
+	requestHash, err := service.HashRequest(payload)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	reservation, stored, err := a.IdempotencyService.Reserve(ctx, key, requestHash)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	if stored != nil {
+		w.Header().Set("Idempotent-Replayed", "true")
+
+		if mediaType == apiout.MediaTypeJSON {
+			apiout.JSON(ctx, w, json.RawMessage(stored.Body), stored.Status)
+			return
+		}
+
+		// the response is stored as JSON, thus it is decoded to be replayed in the negotiated media type
+		var replayed types.Transaction
+		if err := json.Unmarshal(stored.Body, &replayed); err != nil {
+			apiout.Error(ctx, w, err)
+			return
+		}
+
+		apiout.Respond(ctx, w, mediaType, replayed, stored.Status)
+		return
+	}
+
+	// the key must be released or completed even when the client disconnected or the request deadline passed, otherwise
+	// retries are rejected until its lease expires
+	storeCtx := context.WithoutCancel(ctx)
+
+	response, err := a.TransactionService.CreateNewPurchaseTransaction(ctx, payload)
+	if err != nil {
+		// release the key such that the client can retry the failed request
+		if err := a.IdempotencyService.Release(storeCtx, reservation); err != nil {
+			slog.ErrorContext(ctx, "failed to release idempotency key", "idempotency_key", key, "err", err.Error())
+		}
+
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	err = a.IdempotencyService.Complete(storeCtx, reservation, service.IdempotentResponse{Status: http.StatusCreated, Body: body})
+	if err != nil {
+		// transaction is already created, thus we still respond with it
+		slog.ErrorContext(ctx, "failed to store idempotent response", "idempotency_key", key, "err", err.Error())
+	}
+
+	apiout.Respond(ctx, w, mediaType, response, http.StatusCreated)
+}
+
+// POST /purchase/{transaction_id}/conversions
+func (a *API) ConvertPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params types.ConvertPurchaseTransactionParams) {
+	ctx := r.Context()
+
+	var payload types.ConvertPurchaseTransaction
+
+	err := apiout.DecodeJSONBody(w, r, &payload)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	uuidString, err := service.ParseStringToUUID(transactionId)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to parse provided transaction id", "err", err.Error())
+		apiout.Error(ctx, w, apiout.NewFieldError(apiout.CodeInvalidTransactionId, "transactionId", errors.New("invalid transaction id provided")))
+		return
+	}
+
+	transactionDetails, err := a.TransactionService.GetPurchaseDetailsByTransactionId(ctx, uuidString)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	response := types.ConvertedPurchaseTransaction{
+		TransactionDetails: service.GetTransactionDetails(transactionDetails),
+		Conversions:        a.ExchangeRateService.ConvertToCurrencies(ctx, transactionDetails, payload.Targets, getRateSelectionPolicy(params.RatePolicy)),
+	}
+
+	apiout.JSON(ctx, w, response, http.StatusOK)
+}
+
+// POST /purchase/convert
+func (a *API) ConvertPurchaseTransactions(w http.ResponseWriter, r *http.Request, params types.ConvertPurchaseTransactionsParams) {
+	ctx := r.Context()
+
+	var payload types.ConvertPurchaseTransactions
+
+	err := apiout.DecodeJSONBody(w, r, &payload)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	results := make([]types.TransactionConversionResult, len(payload.TransactionIds))
+	requested := make([]uuid.UUID, len(payload.TransactionIds))
+	ids := make([]uuid.UUID, 0, len(payload.TransactionIds))
+
+	for i, transactionId := range payload.TransactionIds {
+		results[i].TransactionId = transactionId
+
+		id, err := service.ParseStringToUUID(transactionId)
+		if err != nil {
+			setTransactionConversionError(&results[i], apiout.CodeInvalidTransactionId, "invalid transaction id provided")
+			continue
+		}
+
+		requested[i] = id
+		ids = append(ids, id)
+	}
+
+	transactions := []*ent.Transaction{}
+	if len(ids) > 0 {
+		transactions, err = a.TransactionService.GetPurchaseDetailsByTransactionIds(ctx, ids)
+		if err != nil {
+			apiout.Error(ctx, w, err)
+			return
+		}
+	}
+
+	target := types.ConversionTarget{Country: payload.Country, Currency: payload.Currency}
+	conversions := a.ExchangeRateService.ConvertTransactions(ctx, transactions, target, getRateSelectionPolicy(params.RatePolicy))
+
+	byId := make(map[uuid.UUID]int, len(transactions))
+	for i, transaction := range transactions {
+		byId[transaction.ID] = i
+	}
+
+	for i := range results {
+		if results[i].Error != nil {
+			continue
+		}
+
+		j, ok := byId[requested[i]]
+		if !ok {
+			setTransactionConversionError(&results[i], apiout.CodeTransactionNotFound, "given transaction id not found")
+			continue
+		}
+
+		transactionDetails := service.GetTransactionDetails(transactions[j])
+		results[i].TransactionDetails = &transactionDetails
+		results[i].Status = conversions[j].Status
+		results[i].ConvertedDetails = conversions[j].ConvertedDetails
+		results[i].Error = conversions[j].Error
+		results[i].ErrorCode = conversions[j].ErrorCode
+	}
+
+	apiout.JSON(ctx, w, types.ConvertedPurchaseTransactions{Results: results}, http.StatusOK)
+}
+
+func setTransactionConversionError(result *types.TransactionConversionResult, code apiout.ErrorCode, message string) {
+	errorCode := string(code)
+	result.Status = code.Status()
+	result.Error = &message
+	result.ErrorCode = &errorCode
+}
+
+// GET /purchase?cursor=""&limit=20&country=""&currency=""&ratePolicy=""
+func (a *API) ListPurchaseTransactions(w http.ResponseWriter, r *http.Request, params types.ListPurchaseTransactionsParams) {
+	ctx := r.Context()
+
+	mediaType, err := apiout.Negotiate(r, apiout.ResponseMediaTypes...)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	if (params.Country == nil) != (params.Currency == nil) {
+		missing := "country"
+		if params.Currency == nil {
+			missing = "currency"
+		}
+
+		apiout.Error(ctx, w, apiout.NewFieldError(apiout.CodeInvalidConversionTarget, missing, errors.New("country and currency must be provided together")))
+		return
+	}
+
+	transactions, nextCursor, err := a.TransactionService.ListPurchaseTransactions(ctx, params)
+	if err != nil {
+		apiout.Error(ctx, w, err)
+		return
+	}
+
+	response := types.ListPurchaseTransactions{
+		Items:      make([]types.PurchaseTransactionListItem, 0, len(transactions)),
+		NextCursor: nextCursor,
+	}
+
+	for _, transaction := range transactions {
+		response.Items = append(response.Items, types.PurchaseTransactionListItem{
+			TransactionDetails: service.GetTransactionDetails(transaction),
+		})
+	}
+
+	if params.Country != nil {
+		err = a.convertListItems(ctx, response.Items, transactions, *params.Country, *params.Currency, getRateSelectionPolicy(params.RatePolicy))
+		if err != nil {
+			apiout.Error(ctx, w, err)
+			return
+		}
+	}
+
+	// CSV has no place for the cursor, thus it is always returned as header as well
+	if nextCursor != nil {
+		w.Header().Set("Next-Cursor", *nextCursor)
+	}
+
+	apiout.Respond(ctx, w, mediaType, response, http.StatusOK)
+}
+
+// convertListItems will convert the transactions of the page to provided currency in one batch, which looks up each exchange
+// rate once per rate window. Transactions which cannot be converted to the target currency will not fail the whole page,
+// instead the reason is reported for that item. Other failures, e.g. the exchange rate service being unavailable, fail the page.
+func (a *API) convertListItems(ctx context.Context, items []types.PurchaseTransactionListItem, transactions []*ent.Transaction, country, currency string, policy service.RateSelectionPolicy) error {
+	target := types.ConversionTarget{Country: country, Currency: currency}
+	conversions := a.ExchangeRateService.ConvertTransactions(ctx, transactions, target, policy)
+
+	for i, conversion := range conversions {
+		switch conversion.Status {
+		case http.StatusOK:
+			items[i].ConvertedDetails = conversion.ConvertedDetails
+		case http.StatusBadRequest:
+			items[i].ConversionError = conversion.Error
+			items[i].ConversionErrorCode = conversion.ErrorCode
+		default:
+			return &apiout.APIError{
+				Err:    errors.New(*conversion.Error),
+				Status: conversion.Status,
+				Code:   apiout.ErrorCode(*conversion.ErrorCode),
+			}
+		}
+	}
+
+	return nil
 }
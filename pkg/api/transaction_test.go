@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,10 +13,10 @@ import (
 	"github.com/eddie023/wex-tag/ent"
 	"github.com/eddie023/wex-tag/pkg/api/mocks"
 	"github.com/eddie023/wex-tag/pkg/api/service"
+	"github.com/eddie023/wex-tag/pkg/apiout"
 	"github.com/eddie023/wex-tag/pkg/types"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
-	httpMiddleware "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/shopspring/decimal"
 	"go.uber.org/mock/gomock"
 	"gotest.tools/assert"
@@ -29,9 +30,12 @@ import (
 // This can be overriden by providing a custom clock with the withClock() option.
 func newTestServer(t *testing.T, a *API) http.Handler {
 	r := chi.NewRouter()
+	r.Use(apiout.ProblemInstance)
+	r.NotFound(apiout.NotFound)
+	r.MethodNotAllowed(apiout.MethodNotAllowed)
 
 	r.Group(func(r chi.Router) {
-		r.Use(httpMiddleware.OapiRequestValidator(a.Swagger))
+		r.Use(a.requestValidator())
 		types.HandlerWithOptions(a, types.ChiServerOptions{
 			BaseRouter: r,
 		})
@@ -61,6 +65,8 @@ func TestPostTransactionAPI(t *testing.T) {
 		t.Fatal()
 	}
 
+	originalAmount, originalCurrency, originalExchangeRate, originalExchangeRateDate := "100", "EUR", "0.918", "2020-09-30"
+
 	testcases := []testcase{
 		{
 			name:     "should fail for emtpy json body with property missing error",
@@ -75,22 +81,36 @@ func TestPostTransactionAPI(t *testing.T) {
 			},
 			mockCreateErr: nil,
 
-			wantBody: `property "description" is missing`,
+			wantBody: `property \"description\" is missing`,
 		},
 		{
 			name:     "should fail if amount field is not provided",
 			give:     `{"description": ""}`,
 			wantCode: http.StatusBadRequest,
 
+			mockPurchaseTransaction: &types.Transaction{},
+			mockCreateErr:           apiout.NewFieldError(apiout.CodeInvalidAmount, "amount", errors.New("either amount or originalAmount and originalCurrency must be provided")),
+
+			wantBody: `"code":"invalid_amount","invalidParams":[{"name":"amount","reason":"either amount or originalAmount and originalCurrency must be provided"}]`,
+		},
+		{
+			name:     "should successfully generate new purchase transaction made in foreign currency",
+			give:     `{"description": "","originalAmount": "100","originalCurrency": "EUR"}`,
+			wantCode: http.StatusCreated,
+
 			mockPurchaseTransaction: &types.Transaction{
-				AmountInUSD: "123.45",
-				Date:        testDate,
-				Description: "abcd",
-				Id:          testUUID.String(),
+				AmountInUSD:              "108.93",
+				Date:                     testDate.UTC(),
+				Description:              "",
+				Id:                       testUUID.String(),
+				OriginalAmount:           &originalAmount,
+				OriginalCurrency:         &originalCurrency,
+				OriginalExchangeRate:     &originalExchangeRate,
+				OriginalExchangeRateDate: &originalExchangeRateDate,
 			},
 			mockCreateErr: nil,
 
-			wantBody: `property "amount" is missing`,
+			wantBody: `{"amountInUSD":"108.93","date":"2020-10-10T00:00:00Z","description":"","id":"680ed945-c2c3-4534-84e8-4ba6ed69eeea","originalAmount":"100","originalCurrency":"EUR","originalExchangeRate":"0.918","originalExchangeRateDate":"2020-09-30"}`,
 		},
 		{
 			name:     "should successfully generate new purchase transaction details",
@@ -120,7 +140,7 @@ func TestPostTransactionAPI(t *testing.T) {
 			},
 			mockCreateErr: nil,
 
-			wantBody: `Error at "/description": maximum string length is 50`,
+			wantBody: `{"in":"body","name":"/description","reason":"maximum string length is 50"}`,
 		},
 	}
 
@@ -212,7 +232,7 @@ func TestGetTransactionAPI(t *testing.T) {
 			give:             `{}`,
 			wantCode:         http.StatusBadRequest,
 			mockExchangeRate: &service.ExchangeRateResponse{},
-			wantBody:         `parameter "country" in query has an error: value is required but missing`,
+			wantBody:         `"invalidParams":[{"name":"country","reason":"either country and currency or countryCode and/or currencyCode must be provided"}]`,
 		},
 		{
 			name:             "should fail if only country param is passed",
@@ -220,7 +240,52 @@ func TestGetTransactionAPI(t *testing.T) {
 			give:             `{}`,
 			wantCode:         http.StatusBadRequest,
 			mockExchangeRate: &service.ExchangeRateResponse{},
-			wantBody:         `parameter "currency" in query has an error: value is required but missing`,
+			wantBody:         `"invalidParams":[{"name":"currency","reason":"country and currency must be provided together"}]`,
+		},
+		{
+			name:             "should fail for unknown currency code",
+			queryParam:       "currencyCode=XXX",
+			give:             `{}`,
+			wantCode:         http.StatusBadRequest,
+			mockExchangeRate: &service.ExchangeRateResponse{},
+			wantBody:         `"invalidParams":[{"name":"currencyCode","reason":"unknown currency code 'XXX'"}]`,
+		},
+		{
+			name:             "should fail for invalid country code",
+			queryParam:       "countryCode=GBR",
+			give:             `{}`,
+			wantCode:         http.StatusBadRequest,
+			mockExchangeRate: &service.ExchangeRateResponse{},
+			wantBody:         `"name":"countryCode"`,
+		},
+		{
+			name:             "should fail if country is combined with currency code",
+			queryParam:       "country=Nepal&currencyCode=NPR",
+			give:             `{}`,
+			wantCode:         http.StatusBadRequest,
+			mockExchangeRate: &service.ExchangeRateResponse{},
+			wantBody:         `"invalidParams":[{"name":"countryCode","reason":"country and currency must not be combined with countryCode or currencyCode"}]`,
+		},
+		{
+			name:             "should fail for unknown rate policy",
+			queryParam:       "country=Nepal&currency=Rupee&ratePolicy=closest",
+			give:             `{}`,
+			wantCode:         http.StatusBadRequest,
+			mockExchangeRate: &service.ExchangeRateResponse{},
+			wantBody:         `"name":"ratePolicy"`,
+		},
+		{
+			name:             "should successfully return for valid currency code",
+			queryParam:       "currencyCode=npr",
+			give:             `{}`,
+			wantCode:         http.StatusOK,
+			mockExchangeRate: &service.ExchangeRateResponse{},
+			mockTransactionDetail: &ent.Transaction{
+				ID:          testUUID,
+				Date:        testDate,
+				AmountInUsd: decimal.NewFromInt(100),
+			},
+			wantBody: `{"convertedDetails":{"amount":"","country":"","currency":"","exchangeRateDate":"","exchangeRateUsed":""},"transactionDetails":{"amountInUSD":"","date":"0001-01-01T00:00:00Z","description":"","id":""}}`,
 		},
 		{
 			name:             "should successfully return for valid query params",
@@ -246,7 +311,7 @@ func TestGetTransactionAPI(t *testing.T) {
 			exm := mocks.NewMockExchangeRateService(ctrl)
 			if tc.mockExchangeRate != nil {
 				exm.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Return(*tc.mockExchangeRate, tc.mockExchangeRateErr).AnyTimes()
-				exm.EXPECT().ConvertCurrency(gomock.Any(), gomock.Any(), gomock.Any()).Return(types.GetPurchaseTransaction{}, nil).AnyTimes()
+				exm.EXPECT().ConvertCurrency(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(types.GetPurchaseTransaction{}, nil).AnyTimes()
 			}
 
 			transm := mocks.NewMockTransactionService(ctrl)
@@ -304,3 +369,768 @@ func TestGetTransactionAPI(t *testing.T) {
 	}
 
 }
+
+func TestGetConversionTarget(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	type testcase struct {
+		name   string
+		params types.GetPurchaseTransactionParams
+
+		want    service.ExchangeRatePayload
+		wantErr string
+	}
+
+	testcases := []testcase{
+		{
+			name:   "should use country and currency names",
+			params: types.GetPurchaseTransactionParams{Country: str("Nepal"), Currency: str("Rupee")},
+			want:   service.ExchangeRatePayload{CountryName: "Nepal", Currency: "Rupee"},
+		},
+		{
+			name:   "should resolve currency code",
+			params: types.GetPurchaseTransactionParams{CurrencyCode: str("GBP")},
+			want:   service.ExchangeRatePayload{CountryName: "United Kingdom", Currency: "Pound"},
+		},
+		{
+			name:   "should resolve shared currency code to its default country",
+			params: types.GetPurchaseTransactionParams{CurrencyCode: str("EUR")},
+			want:   service.ExchangeRatePayload{CountryName: "Euro Zone", Currency: "Euro"},
+		},
+		{
+			name:   "should resolve shared currency code by country code",
+			params: types.GetPurchaseTransactionParams{CountryCode: str("DE"), CurrencyCode: str("EUR")},
+			want:   service.ExchangeRatePayload{CountryName: "Germany", Currency: "Euro"},
+		},
+		{
+			name:    "should fail for unknown country code",
+			params:  types.GetPurchaseTransactionParams{CountryCode: str("XX")},
+			wantErr: "unknown country code 'XX'",
+		},
+		{
+			name:    "should fail for currency not used in the country",
+			params:  types.GetPurchaseTransactionParams{CountryCode: str("GB"), CurrencyCode: str("EUR")},
+			wantErr: "currency 'EUR' is not used in country 'GB'",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := getConversionTarget(tc.params)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestListTransactionAPI(t *testing.T) {
+	type testcase struct {
+		name           string
+		queryParam     string
+		mockConversion types.CurrencyConversionResult
+
+		wantCode int
+		wantBody string
+	}
+
+	testDate, err := time.Parse(time.DateOnly, "2020-10-10")
+	if err != nil {
+		t.Fatal()
+	}
+
+	testUUID, err := uuid.Parse("680ed945-c2c3-4534-84e8-4ba6ed69eeea")
+	if err != nil {
+		t.Fatal()
+	}
+
+	mockTransactions := []*ent.Transaction{
+		{
+			ID:          testUUID,
+			Date:        testDate,
+			AmountInUsd: decimal.NewFromInt(100),
+			Description: "foo",
+		},
+	}
+
+	converted := types.CurrencyConversionResult{
+		Country:  "Nepal",
+		Currency: "Rupee",
+		Status:   http.StatusOK,
+		ConvertedDetails: &types.ConvertedPurchasePrice{
+			Amount:              "13050",
+			Country:             "Nepal",
+			CountryCurrencyDesc: ptr("Nepal-Rupee"),
+			Currency:            "Rupee",
+			ExchangeRateDate:    "2020-09-30",
+			ExchangeRateUsed:    "130.5",
+		},
+	}
+
+	testcases := []testcase{
+		{
+			name:       "should fail if only country param is passed",
+			queryParam: "country=Nepal",
+			wantCode:   http.StatusBadRequest,
+			wantBody:   `country and currency must be provided together`,
+		},
+		{
+			name:       "should fail for limit greater than maximum",
+			queryParam: "limit=1000",
+			wantCode:   http.StatusBadRequest,
+			wantBody:   `{"in":"query","name":"limit","reason":"number must be at most 100"}`,
+		},
+		{
+			name:       "should successfully list transactions without conversion",
+			queryParam: "limit=1",
+			wantCode:   http.StatusOK,
+			wantBody:   `{"items":[{"transactionDetails":{"amountInUSD":"100","date":"2020-10-10T00:00:00Z","description":"foo","id":"680ed945-c2c3-4534-84e8-4ba6ed69eeea"}}],"nextCursor":"next"}`,
+		},
+		{
+			name:           "should successfully list converted transactions",
+			queryParam:     "country=Nepal&currency=Rupee",
+			mockConversion: converted,
+			wantCode:       http.StatusOK,
+			wantBody:       `{"items":[{"convertedDetails":{"amount":"13050","country":"Nepal","countryCurrencyDesc":"Nepal-Rupee","currency":"Rupee","exchangeRateDate":"2020-09-30","exchangeRateUsed":"130.5"},"transactionDetails":{"amountInUSD":"100","date":"2020-10-10T00:00:00Z","description":"foo","id":"680ed945-c2c3-4534-84e8-4ba6ed69eeea"}}],"nextCursor":"next"}`,
+		},
+		{
+			name:       "should report conversion error for transactions that cannot be converted",
+			queryParam: "country=Nepal&currency=Dollar",
+			mockConversion: types.CurrencyConversionResult{
+				Status:    http.StatusBadRequest,
+				Error:     ptr("the purchase cannot be converted to the target currency"),
+				ErrorCode: ptr(string(apiout.CodeExchangeRateNotFound)),
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"items":[{"conversionError":"the purchase cannot be converted to the target currency","conversionErrorCode":"exchange_rate_not_found","transactionDetails":{"amountInUSD":"100","date":"2020-10-10T00:00:00Z","description":"foo","id":"680ed945-c2c3-4534-84e8-4ba6ed69eeea"}}],"nextCursor":"next"}`,
+		},
+		{
+			name:       "should fail the page when exchange rate service is unavailable",
+			queryParam: "country=Nepal&currency=Rupee",
+			mockConversion: types.CurrencyConversionResult{
+				Status:    http.StatusServiceUnavailable,
+				Error:     ptr("exchange rate service is unavailable"),
+				ErrorCode: ptr(string(apiout.CodeExchangeRateServiceUnavailable)),
+			},
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: `"code":"exchange_rate_service_unavailable"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// the page is converted in one batch rather than looking up the rate of every transaction on its own
+			exm := mocks.NewMockExchangeRateService(ctrl)
+			exm.EXPECT().ConvertTransactions(gomock.Any(), mockTransactions, gomock.Any(), gomock.Any()).Return([]types.CurrencyConversionResult{tc.mockConversion}).MaxTimes(1)
+
+			nextCursor := "next"
+			transm := mocks.NewMockTransactionService(ctrl)
+			transm.EXPECT().ListPurchaseTransactions(gomock.Any(), gomock.Any()).Return(mockTransactions, &nextCursor, nil).AnyTimes()
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// remove any servers from the spec, as we don't know what host or port the user will run the API as.
+			swagger.Servers = nil
+
+			a := API{ExchangeRateService: exm, TransactionService: transm, Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", fmt.Sprintf("/purchase?%s", tc.queryParam), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestPostIdempotentTransactionAPI(t *testing.T) {
+	type testcase struct {
+		name           string
+		mockStored     *service.IdempotentResponse
+		mockReserveErr error
+		mockCreateErr  error
+
+		wantCode     int
+		wantBody     string
+		wantReplayed bool
+	}
+
+	testDate, err := time.Parse(time.DateOnly, "2020-10-10")
+	if err != nil {
+		t.Fatal()
+	}
+
+	transaction := types.Transaction{
+		AmountInUSD: "10",
+		Date:        testDate.UTC(),
+		Description: "foo",
+		Id:          "680ed945-c2c3-4534-84e8-4ba6ed69eeea",
+	}
+
+	testcases := []testcase{
+		{
+			name:     "should create new transaction for first request",
+			wantCode: http.StatusCreated,
+			wantBody: `{"amountInUSD":"10","date":"2020-10-10T00:00:00Z","description":"foo","id":"680ed945-c2c3-4534-84e8-4ba6ed69eeea"}`,
+		},
+		{
+			name:         "should replay stored response for retried request",
+			mockStored:   &service.IdempotentResponse{Status: http.StatusCreated, Body: []byte(`{"id":"stored"}`)},
+			wantCode:     http.StatusCreated,
+			wantBody:     `{"id":"stored"}`,
+			wantReplayed: true,
+		},
+		{
+			name:           "should fail for key used with different request body",
+			mockReserveErr: apiout.NewRequestError(errors.New("idempotency key has already been used with a different request body"), http.StatusUnprocessableEntity),
+			wantCode:       http.StatusUnprocessableEntity,
+			wantBody:       `idempotency key has already been used with a different request body`,
+		},
+		{
+			name:          "should release key when transaction cannot be created",
+			mockCreateErr: apiout.BadRequest("amount cannot be negative number"),
+			wantCode:      http.StatusBadRequest,
+			wantBody:      `amount cannot be negative number`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockTransactionService(ctrl)
+			m.EXPECT().CreateNewPurchaseTransaction(gomock.Any(), gomock.Any()).Return(transaction, tc.mockCreateErr).AnyTimes()
+
+			reservation := service.IdempotencyReservation{Key: "key", RequestHash: "hash", ReservedAt: testDate}
+			if tc.mockStored != nil || tc.mockReserveErr != nil {
+				reservation = service.IdempotencyReservation{}
+			}
+
+			im := mocks.NewMockIdempotencyService(ctrl)
+			im.EXPECT().Reserve(gomock.Any(), "key", gomock.Any()).Return(reservation, tc.mockStored, tc.mockReserveErr)
+			if tc.mockStored == nil && tc.mockReserveErr == nil {
+				if tc.mockCreateErr != nil {
+					im.EXPECT().Release(gomock.Any(), reservation).Return(nil)
+				} else {
+					im.EXPECT().Complete(gomock.Any(), reservation, service.IdempotentResponse{Status: http.StatusCreated, Body: []byte(tc.wantBody)}).Return(nil)
+				}
+			}
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// remove any servers from the spec, as we don't know what host or port the user will run the API as.
+			swagger.Servers = nil
+
+			a := API{TransactionService: m, IdempotencyService: im, Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/purchase", strings.NewReader(`{"description": "foo","amount": "10"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			req.Header.Add("Idempotency-Key", "key")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, tc.wantReplayed, rr.Header().Get("Idempotent-Replayed") == "true")
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestConvertTransactionAPI(t *testing.T) {
+	type testcase struct {
+		name                  string
+		give                  string
+		transactionId         string
+		queryParam            string
+		mockTransactionDetail *ent.Transaction
+		mockConversions       []types.CurrencyConversionResult
+		wantPolicy            service.RateSelectionPolicy
+
+		wantCode int
+		wantBody string
+	}
+
+	testUUID, err := uuid.Parse("680ed945-c2c3-4534-84e8-4ba6ed69eeea")
+	if err != nil {
+		t.Fatal()
+	}
+
+	notFound := "rate not found"
+	nearest := types.RatePolicyNearest
+
+	testcases := []testcase{
+		{
+			name:          "should fail if no target is passed",
+			transactionId: testUUID.String(),
+			give:          `{"targets":[]}`,
+			wantCode:      http.StatusBadRequest,
+			wantBody:      `minimum number of items is 1`,
+		},
+		{
+			name:          "should fail for invalid transaction id",
+			transactionId: "invalid",
+			give:          `{"targets":[{"country":"Nepal","currency":"Rupee"}]}`,
+			wantCode:      http.StatusBadRequest,
+			wantBody:      `invalid transaction id provided`,
+		},
+		{
+			name:          "should return result for every target",
+			transactionId: testUUID.String(),
+			give:          `{"targets":[{"country":"Nepal","currency":"Rupee"},{"country":"Iraq","currency":"Dinar"}]}`,
+			mockTransactionDetail: &ent.Transaction{
+				ID:          testUUID,
+				AmountInUsd: decimal.NewFromInt(10),
+			},
+			mockConversions: []types.CurrencyConversionResult{
+				{Country: "Nepal", Currency: "Rupee", Status: http.StatusOK, ConvertedDetails: &types.ConvertedPurchasePrice{Amount: "1305"}},
+				{Country: "Iraq", Currency: "Dinar", Status: http.StatusBadRequest, Error: &notFound},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"conversions":[{"convertedDetails":{"amount":"1305","country":"","currency":"","exchangeRateDate":"","exchangeRateUsed":""},"country":"Nepal","currency":"Rupee","status":200},{"country":"Iraq","currency":"Dinar","error":"rate not found","status":400}],"transactionDetails":{"amountInUSD":"10","date":"0001-01-01T00:00:00Z","description":"","id":"680ed945-c2c3-4534-84e8-4ba6ed69eeea"}}`,
+		},
+		{
+			name:          "should convert by the requested rate policy",
+			transactionId: testUUID.String(),
+			queryParam:    "ratePolicy=nearest",
+			give:          `{"targets":[{"country":"Nepal","currency":"Rupee"}]}`,
+			mockTransactionDetail: &ent.Transaction{
+				ID:          testUUID,
+				AmountInUsd: decimal.NewFromInt(10),
+			},
+			mockConversions: []types.CurrencyConversionResult{
+				{Country: "Nepal", Currency: "Rupee", Status: http.StatusOK, ConvertedDetails: &types.ConvertedPurchasePrice{Amount: "1305", RatePolicy: &nearest}},
+			},
+			wantPolicy: service.RateSelectionPolicy{Mode: service.Nearest},
+			wantCode:   http.StatusOK,
+			wantBody:   `"ratePolicy":"nearest"`,
+		},
+		{
+			name:          "should fail for unknown rate policy",
+			transactionId: testUUID.String(),
+			queryParam:    "ratePolicy=closest",
+			give:          `{"targets":[{"country":"Nepal","currency":"Rupee"}]}`,
+			wantCode:      http.StatusBadRequest,
+			wantBody:      `"name":"ratePolicy"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			exm := mocks.NewMockExchangeRateService(ctrl)
+			if tc.mockConversions != nil {
+				exm.EXPECT().ConvertToCurrencies(gomock.Any(), tc.mockTransactionDetail, gomock.Len(len(tc.mockConversions)), tc.wantPolicy).Return(tc.mockConversions)
+			}
+
+			transm := mocks.NewMockTransactionService(ctrl)
+			if tc.mockTransactionDetail != nil {
+				transm.EXPECT().GetPurchaseDetailsByTransactionId(gomock.Any(), gomock.Any()).Return(tc.mockTransactionDetail, nil)
+			}
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// remove any servers from the spec, as we don't know what host or port the user will run the API as.
+			swagger.Servers = nil
+
+			a := API{ExchangeRateService: exm, TransactionService: transm, Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", fmt.Sprintf("/purchase/%s/conversions?%s", tc.transactionId, tc.queryParam), strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestConvertTransactionsAPI(t *testing.T) {
+	type testcase struct {
+		name                   string
+		give                   string
+		mockTransactionDetails []*ent.Transaction
+		mockConversions        []types.CurrencyConversionResult
+
+		wantCode int
+		wantBody string
+	}
+
+	testUUID, err := uuid.Parse("680ed945-c2c3-4534-84e8-4ba6ed69eeea")
+	if err != nil {
+		t.Fatal()
+	}
+
+	notFound := "rate not found"
+
+	testcases := []testcase{
+		{
+			name:     "should fail if no transaction id is passed",
+			give:     `{"transactionIds":[],"country":"Nepal","currency":"Rupee"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `minimum number of items is 1`,
+		},
+		{
+			name:     "should fail if currency is not passed",
+			give:     `{"transactionIds":["680ed945-c2c3-4534-84e8-4ba6ed69eeea"],"country":"Nepal"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `property \"currency\" is missing`,
+		},
+		{
+			name:     "should report invalid transaction id without querying transactions",
+			give:     `{"transactionIds":["invalid"],"country":"Nepal","currency":"Rupee"}`,
+			wantCode: http.StatusOK,
+			wantBody: `{"results":[{"error":"invalid transaction id provided","errorCode":"invalid_transaction_id","status":400,"transactionId":"invalid"}]}`,
+		},
+		{
+			name: "should report result of every transaction id in requested order",
+			give: `{"transactionIds":["invalid","680ed945-c2c3-4534-84e8-4ba6ed69eeea","1d3ac5c5-4b69-4fd4-9ac1-6a0e2c1f6b6a"],"country":"Nepal","currency":"Rupee"}`,
+			mockTransactionDetails: []*ent.Transaction{
+				{ID: testUUID, AmountInUsd: decimal.NewFromInt(10)},
+			},
+			mockConversions: []types.CurrencyConversionResult{
+				{Country: "Nepal", Currency: "Rupee", Status: http.StatusBadRequest, Error: &notFound},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"results":[{"error":"invalid transaction id provided","errorCode":"invalid_transaction_id","status":400,"transactionId":"invalid"},{"error":"rate not found","status":400,"transactionDetails":{"amountInUSD":"10","date":"0001-01-01T00:00:00Z","description":"","id":"680ed945-c2c3-4534-84e8-4ba6ed69eeea"},"transactionId":"680ed945-c2c3-4534-84e8-4ba6ed69eeea"},{"error":"given transaction id not found","errorCode":"transaction_not_found","status":404,"transactionId":"1d3ac5c5-4b69-4fd4-9ac1-6a0e2c1f6b6a"}]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			exm := mocks.NewMockExchangeRateService(ctrl)
+			exm.EXPECT().ConvertTransactions(gomock.Any(), gomock.Len(len(tc.mockTransactionDetails)), types.ConversionTarget{Country: "Nepal", Currency: "Rupee"}, service.RateSelectionPolicy{}).Return(tc.mockConversions).AnyTimes()
+
+			transm := mocks.NewMockTransactionService(ctrl)
+			if tc.mockTransactionDetails != nil {
+				transm.EXPECT().GetPurchaseDetailsByTransactionIds(gomock.Any(), gomock.Len(2)).Return(tc.mockTransactionDetails, nil)
+			}
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// remove any servers from the spec, as we don't know what host or port the user will run the API as.
+			swagger.Servers = nil
+
+			a := API{ExchangeRateService: exm, TransactionService: transm, Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/purchase/convert", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestProblemResponseAPI(t *testing.T) {
+	type testcase struct {
+		name   string
+		method string
+		path   string
+		give   string
+
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "should report invalid transaction id with the failing field",
+			method:   "GET",
+			path:     "/purchase/invalid?country=Nepal&currency=Rupee",
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"type":"urn:wex-tag:problem:invalid_transaction_id","title":"Invalid transaction id","status":400,"detail":"invalid transaction id provided","instance":"/purchase/invalid","code":"invalid_transaction_id","invalidParams":[{"name":"transactionId","reason":"invalid transaction id provided"}]}`,
+		},
+		{
+			name:     "should report request validator failures",
+			method:   "POST",
+			path:     "/purchase",
+			give:     `{}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `"code":"validation_failed"`,
+		},
+		{
+			name:     "should report every violation of the request body at once",
+			method:   "POST",
+			path:     "/purchase",
+			give:     `{"description": "text that is longer than 50 character text is longer than 50 character","amount": 10}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `"detail":"/amount: value must be a string; /description: maximum string length is 50","instance":"/purchase","code":"validation_failed","errors":[{"in":"body","name":"/amount","reason":"value must be a string"},{"in":"body","name":"/description","reason":"maximum string length is 50"}]`,
+		},
+		{
+			name:     "should report every violation of the query parameters at once",
+			method:   "GET",
+			path:     "/purchase?limit=0&fromDate=yesterday",
+			wantCode: http.StatusBadRequest,
+			wantBody: `"errors":[{"in":"query","name":"limit","reason":"number must be at least 1"},{"in":"query","name":"fromDate",`,
+		},
+		{
+			name:     "should report unknown routes",
+			method:   "GET",
+			path:     "/unknown",
+			wantCode: http.StatusNotFound,
+			wantBody: `"code":"not_found"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// remove any servers from the spec, as we don't know what host or port the user will run the API as.
+			swagger.Servers = nil
+
+			a := API{ExchangeRateService: mocks.NewMockExchangeRateService(ctrl), TransactionService: mocks.NewMockTransactionService(ctrl), Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest(tc.method, tc.path, strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, apiout.ProblemContentType, rr.Header().Get("Content-Type"))
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestContentNegotiationAPI(t *testing.T) {
+	type testcase struct {
+		name       string
+		method     string
+		path       string
+		give       string
+		giveAccept string
+
+		wantCode        int
+		wantContentType string
+		wantBody        string
+		wantHeader      http.Header
+	}
+
+	testUUID, err := uuid.Parse("680ed945-c2c3-4534-84e8-4ba6ed69eeea")
+	if err != nil {
+		t.Fatal()
+	}
+
+	testDate, err := time.Parse(time.DateOnly, "2020-10-10")
+	if err != nil {
+		t.Fatal()
+	}
+
+	transaction := &ent.Transaction{ID: testUUID, Date: testDate, AmountInUsd: decimal.NewFromInt(100), Description: "foo"}
+	converted := types.GetPurchaseTransaction{
+		TransactionDetails: types.Transaction{Id: testUUID.String(), Date: testDate, AmountInUSD: "100", Description: "foo"},
+		ConvertedDetails:   types.ConvertedPurchasePrice{Country: "Nepal", Currency: "Rupee", ExchangeRateUsed: "130.5", ExchangeRateDate: "2020-09-30", Amount: "13050"},
+	}
+	nextCursor := "next"
+
+	testcases := []testcase{
+		{
+			name:            "should respond with xml",
+			method:          "GET",
+			path:            fmt.Sprintf("/purchase/%s?country=Nepal&currency=Rupee", testUUID),
+			giveAccept:      "application/xml",
+			wantCode:        http.StatusOK,
+			wantContentType: apiout.MediaTypeXML,
+			wantBody:        `<GetPurchaseTransaction><convertedDetails><amount>13050</amount><country>Nepal</country><currency>Rupee</currency><exchangeRateDate>2020-09-30</exchangeRateDate><exchangeRateUsed>130.5</exchangeRateUsed></convertedDetails><transactionDetails><amountInUSD>100</amountInUSD><date>2020-10-10T00:00:00Z</date><description>foo</description><id>680ed945-c2c3-4534-84e8-4ba6ed69eeea</id></transactionDetails></GetPurchaseTransaction>`,
+		},
+		{
+			name:            "should respond with csv",
+			method:          "GET",
+			path:            fmt.Sprintf("/purchase/%s?country=Nepal&currency=Rupee", testUUID),
+			giveAccept:      "text/csv",
+			wantCode:        http.StatusOK,
+			wantContentType: apiout.MediaTypeCSV,
+			wantBody:        "id,date,description,amountInUSD,originalAmount,originalCurrency,originalExchangeRate,originalExchangeRateDate,country,currency,exchangeRateUsed,exchangeRateDate,amount,provider,countryCode,currencyCode,ratePolicy,roundingMode,sourceAmount,sourceCurrency,sourceExchangeRateUsed,sourceExchangeRateDate\n680ed945-c2c3-4534-84e8-4ba6ed69eeea,2020-10-10T00:00:00Z,foo,100,,,,,Nepal,Rupee,130.5,2020-09-30,13050,,,,,,,,,\n",
+		},
+		{
+			name:            "should create purchase and respond with xml",
+			method:          "POST",
+			path:            "/purchase",
+			give:            `{"description": "foo","amount": "100"}`,
+			giveAccept:      "text/xml",
+			wantCode:        http.StatusCreated,
+			wantContentType: apiout.MediaTypeXML,
+			wantBody:        `<Transaction><amountInUSD>100</amountInUSD><date>2020-10-10T00:00:00Z</date><description>foo</description><id>680ed945-c2c3-4534-84e8-4ba6ed69eeea</id></Transaction>`,
+		},
+		{
+			name:            "should list purchases as csv with the cursor as header",
+			method:          "GET",
+			path:            "/purchase?limit=1",
+			giveAccept:      "text/csv",
+			wantCode:        http.StatusOK,
+			wantContentType: apiout.MediaTypeCSV,
+			wantBody:        "680ed945-c2c3-4534-84e8-4ba6ed69eeea,2020-10-10T00:00:00Z,foo,100,,,,,,,,,,,,,,,,,,,,\n",
+			wantHeader:      http.Header{"Next-Cursor": []string{nextCursor}},
+		},
+		{
+			name:            "should fail with not acceptable before processing the request",
+			method:          "POST",
+			path:            "/purchase",
+			give:            `{"description": "foo","amount": "100"}`,
+			giveAccept:      "image/png",
+			wantCode:        http.StatusNotAcceptable,
+			wantContentType: apiout.ProblemContentType,
+			wantBody:        `"code":"not_acceptable"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			exm := mocks.NewMockExchangeRateService(ctrl)
+			transm := mocks.NewMockTransactionService(ctrl)
+
+			if tc.wantCode != http.StatusNotAcceptable {
+				exm.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Return(service.ExchangeRateResponse{}, nil).AnyTimes()
+				exm.EXPECT().ConvertCurrency(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(converted, nil).AnyTimes()
+				transm.EXPECT().GetPurchaseDetailsByTransactionId(gomock.Any(), gomock.Any()).Return(transaction, nil).AnyTimes()
+				transm.EXPECT().CreateNewPurchaseTransaction(gomock.Any(), gomock.Any()).Return(converted.TransactionDetails, nil).AnyTimes()
+				transm.EXPECT().ListPurchaseTransactions(gomock.Any(), gomock.Any()).Return([]*ent.Transaction{transaction}, &nextCursor, nil).AnyTimes()
+			}
+
+			swagger, err := types.GetSwagger()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// remove any servers from the spec, as we don't know what host or port the user will run the API as.
+			swagger.Servers = nil
+
+			a := API{ExchangeRateService: exm, TransactionService: transm, Swagger: swagger}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest(tc.method, tc.path, strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			req.Header.Add("Accept", tc.giveAccept)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, tc.wantContentType, rr.Header().Get("Content-Type"))
+
+			for k := range tc.wantHeader {
+				assert.Equal(t, tc.wantHeader.Get(k), rr.Header().Get(k))
+			}
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(data), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+// validationErrors carries every violation found by the request validator from its MultiErrorHandler to its ErrorHandler.
+// The validator middleware only passes the error message to the ErrorHandler, thus the violations are encoded into the message.
+type validationErrors []apiout.Violation
+
+func (v validationErrors) Error() string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "request validation failed"
+	}
+
+	return string(b)
+}
+
+// validationResponseWriter carries the context of the request to the ErrorHandler of the request validator, which is
+// only given the response writer.
+type validationResponseWriter struct {
+	http.ResponseWriter
+	ctx context.Context
+}
+
+// withValidationErrorContext wraps the request validator such that its ErrorHandler can build the problem details with
+// the context of the request. The wrapped response writer is only seen by the validator, not by the next handler.
+func withValidationErrorContext(validate func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		h := validate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if vw, ok := w.(*validationResponseWriter); ok {
+				w = vw.ResponseWriter
+			}
+
+			next.ServeHTTP(w, r)
+		}))
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(&validationResponseWriter{ResponseWriter: w, ctx: r.Context()}, r)
+		})
+	}
+}
+
+// handleValidationErrors is the MultiErrorHandler of the request validator. It collects every violation of the request
+// rather than only the first one.
+func handleValidationErrors(me openapi3.MultiError) (int, error) {
+	return http.StatusBadRequest, validationErrors(getViolations(me))
+}
+
+// writeValidationError is the ErrorHandler of the request validator. It writes the violations collected by handleValidationErrors
+// as problem details listing the location, the failing parameter or JSON pointer and the reason of each violation in 'errors'.
+func writeValidationError(w http.ResponseWriter, message string, statusCode int) {
+	ctx := context.Background()
+	if vw, ok := w.(*validationResponseWriter); ok {
+		ctx = vw.ctx
+		w = vw.ResponseWriter
+	}
+
+	var violations validationErrors
+	if statusCode != http.StatusBadRequest || json.Unmarshal([]byte(message), &violations) != nil || len(violations) == 0 {
+		apiout.RequestValidationError(ctx, w, message, statusCode)
+		return
+	}
+
+	reasons := make([]string, 0, len(violations))
+	for _, v := range violations {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", v.Name, v.Reason))
+	}
+
+	apiout.Error(ctx, w, &apiout.APIError{
+		Err:        errors.New(strings.Join(reasons, "; ")),
+		Status:     http.StatusBadRequest,
+		Code:       apiout.CodeValidationFailed,
+		Violations: violations,
+	})
+}
+
+// getViolations will flatten the kin-openapi validation error into the violations of each parameter and request body field.
+// Parameters are reported by their name and request body fields by their JSON pointer, e.g. '/description'.
+func getViolations(err error) []apiout.Violation {
+	// kin-openapi errors wrap each other and MultiError matches errors.As for any of its errors, thus the exact type is checked
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var violations []apiout.Violation
+		for _, err := range e {
+			violations = append(violations, getViolations(err)...)
+		}
+
+		return violations
+
+	case *openapi3filter.RequestError:
+		return getRequestErrorViolations(e)
+
+	case *openapi3.SchemaError:
+		return []apiout.Violation{{In: "body", Name: getJSONPointer(e), Reason: e.Reason}}
+
+	default:
+		return []apiout.Violation{{Name: "request", Reason: err.Error()}}
+	}
+}
+
+func getRequestErrorViolations(e *openapi3filter.RequestError) []apiout.Violation {
+	var in string
+	name := "request"
+	switch {
+	case e.Parameter != nil:
+		in, name = e.Parameter.In, e.Parameter.Name
+	case e.RequestBody != nil:
+		in, name = "body", "body"
+	}
+
+	switch err := e.Err.(type) {
+	case nil:
+		return []apiout.Violation{{In: in, Name: name, Reason: e.Reason}}
+
+	case openapi3.MultiError:
+		var violations []apiout.Violation
+		for _, err := range err {
+			violations = append(violations, getSchemaErrorViolation(in, name, e.Parameter != nil, err))
+		}
+
+		return violations
+
+	default:
+		return []apiout.Violation{getSchemaErrorViolation(in, name, e.Parameter != nil, err)}
+	}
+}
+
+// getSchemaErrorViolation will report schema violations of a parameter by the parameter name and of the request body by the JSON pointer of the field.
+func getSchemaErrorViolation(in, name string, isParameter bool, err error) apiout.Violation {
+	schemaErr, ok := err.(*openapi3.SchemaError)
+	if !ok {
+		return apiout.Violation{In: in, Name: name, Reason: err.Error()}
+	}
+
+	if !isParameter {
+		name = getJSONPointer(schemaErr)
+	}
+
+	return apiout.Violation{In: in, Name: name, Reason: schemaErr.Reason}
+}
+
+func getJSONPointer(err *openapi3.SchemaError) string {
+	pointer := err.JSONPointer()
+	if len(pointer) == 0 {
+		return "body"
+	}
+
+	return "/" + strings.Join(pointer, "/")
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eddie023/wex-tag/pkg/apiout"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"gotest.tools/assert"
+)
+
+func TestGetViolations(t *testing.T) {
+	country := &openapi3.Parameter{Name: "country", In: "query"}
+	limit := &openapi3.Parameter{Name: "limit", In: "query"}
+	body := &openapi3.RequestBody{}
+
+	tests := []struct {
+		name string
+		give error
+		want []apiout.Violation
+	}{
+		{
+			name: "should report missing parameter by name",
+			give: openapi3.MultiError{&openapi3filter.RequestError{Parameter: country, Err: errors.New("value is required but missing")}},
+			want: []apiout.Violation{{In: "query", Name: "country", Reason: "value is required but missing"}},
+		},
+		{
+			name: "should report schema violations of parameter by name",
+			give: openapi3.MultiError{&openapi3filter.RequestError{Parameter: limit, Err: openapi3.MultiError{&openapi3.SchemaError{Reason: "number must be at most 100"}}}},
+			want: []apiout.Violation{{In: "query", Name: "limit", Reason: "number must be at most 100"}},
+		},
+		{
+			name: "should report schema violations of request body by JSON pointer",
+			give: openapi3.MultiError{&openapi3filter.RequestError{RequestBody: body, Reason: "doesn't match schema", Err: openapi3.MultiError{
+				&openapi3.SchemaError{Reason: "property \"amount\" is missing"},
+			}}},
+			want: []apiout.Violation{{In: "body", Name: "body", Reason: "property \"amount\" is missing"}},
+		},
+		{
+			name: "should report request body errors without schema violations",
+			give: openapi3.MultiError{&openapi3filter.RequestError{RequestBody: body, Reason: "value is required but missing"}},
+			want: []apiout.Violation{{In: "body", Name: "body", Reason: "value is required but missing"}},
+		},
+		{
+			name: "should report every violation",
+			give: openapi3.MultiError{
+				&openapi3filter.RequestError{Parameter: country, Err: errors.New("value is required but missing")},
+				&openapi3filter.RequestError{Parameter: limit, Err: &openapi3.SchemaError{Reason: "number must be at least 1"}},
+			},
+			want: []apiout.Violation{{In: "query", Name: "country", Reason: "value is required but missing"}, {In: "query", Name: "limit", Reason: "number must be at least 1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, tt.want, getViolations(tt.give))
+		})
+	}
+}
+
+func TestWriteValidationError(t *testing.T) {
+	tests := []struct {
+		name       string
+		giveErr    error
+		giveStatus int
+		wantBody   string
+	}{
+		{
+			name:       "should write collected violations",
+			giveErr:    validationErrors{{In: "query", Name: "limit", Reason: "number must be at most 100"}},
+			giveStatus: http.StatusBadRequest,
+			wantBody:   `{"type":"urn:wex-tag:problem:validation_failed","title":"Request validation failed","status":400,"detail":"limit: number must be at most 100","instance":"/purchase","code":"validation_failed","errors":[{"in":"query","name":"limit","reason":"number must be at most 100"}]}` + "\n",
+		},
+		{
+			name:       "should write plain validator failures",
+			giveErr:    errors.New("no matching operation was found"),
+			giveStatus: http.StatusNotFound,
+			wantBody:   `{"type":"urn:wex-tag:problem:not_found","title":"Resource not found","status":404,"detail":"no matching operation was found","instance":"/purchase","code":"not_found"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					writeValidationError(w, tt.giveErr.Error(), tt.giveStatus)
+				})
+			}
+
+			h := apiout.ProblemInstance(withValidationErrorContext(validate)(http.NotFoundHandler()))
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/purchase", nil))
+
+			assert.Equal(t, tt.giveStatus, w.Code)
+			assert.Equal(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestWithValidationErrorContext(t *testing.T) {
+	validate := func(next http.Handler) http.Handler {
+		return next
+	}
+
+	var got http.ResponseWriter
+	h := withValidationErrorContext(validate)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = w
+	}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/purchase", nil))
+
+	// the next handler should be given the original response writer rather than the one wrapped for the validator
+	assert.Equal(t, http.ResponseWriter(w), got)
+}
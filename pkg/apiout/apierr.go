@@ -7,22 +7,59 @@ import "errors"
 type APIError struct {
 	Err    error
 	Status int
+	// Code is the machine readable error code from the error catalog. When empty, the code is derived from the status.
+	Code ErrorCode
+	// Fields are the request fields which caused the error.
+	Fields []InvalidParam
+	// Violations are the violations of the OpenAPI specification which caused the error.
+	Violations []Violation
 }
 
 // NewRequestError wraps a provided error with an HTTP status code. This
 // function should be used when handlers encounter expected errors.
 func NewRequestError(err error, status int) error {
-	return &APIError{err, status}
+	return &APIError{Err: err, Status: status}
+}
+
+// NewCodedError wraps a provided error with an error code from the error catalog.
+// The HTTP status of the error is the status registered for the code.
+func NewCodedError(code ErrorCode, err error) error {
+	return &APIError{Err: err, Status: code.Status(), Code: code}
+}
+
+// NewFieldError wraps a provided error with an error code from the error catalog
+// and reports the given request field as the cause of the error.
+func NewFieldError(code ErrorCode, field string, err error) error {
+	return &APIError{
+		Err:    err,
+		Status: code.Status(),
+		Code:   code,
+		Fields: []InvalidParam{{Name: field, Reason: err.Error()}},
+	}
 }
 
 func (e *APIError) Error() string {
 	return e.Err.Error()
 }
 
+// Unwrap returns the wrapped error such that errors.Is and errors.As can match the cause.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
 func (e *APIError) GetHttpStatus() int {
 	return e.Status
 }
 
+// GetCode will return the error code of the error, falling back to the generic code of its status.
+func (e *APIError) GetCode() ErrorCode {
+	if e.Code != "" {
+		return e.Code
+	}
+
+	return CodeForStatus(e.Status)
+}
+
 func IsApiError(err error) bool {
 	var be *APIError
 
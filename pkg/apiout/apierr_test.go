@@ -22,10 +22,10 @@ func TestDecodeJSONBody(t *testing.T) {
 
 	testcases := []testcase{
 		{name: "ok", giveBody: `{"test": "ok"}`, giveContentType: "application/json", wantErr: nil},
-		{name: "no close bracket", giveBody: `{`, giveContentType: "application/json", wantErr: &APIError{Err: errors.New("request body contains badly-formed JSON"), Status: http.StatusBadRequest}},
-		{name: "multiple objects", giveBody: `{"test": "ok"}{"second": "ok"}`, giveContentType: "application/json", wantErr: &APIError{Err: errors.New("request body must only contain a single JSON object"), Status: http.StatusBadRequest}},
-		{name: "empty", giveBody: ``, giveContentType: "application/json", wantErr: &APIError{Err: errors.New("request body must not be empty"), Status: http.StatusBadRequest}},
-		{name: "invalid content type", giveBody: `{"test": "ok"}`, giveContentType: "other", wantErr: &APIError{Err: errors.New("Content-Type header is not application/json"), Status: http.StatusUnsupportedMediaType}},
+		{name: "no close bracket", giveBody: `{`, giveContentType: "application/json", wantErr: &APIError{Err: errors.New("request body contains badly-formed JSON"), Status: http.StatusBadRequest, Code: CodeInvalidRequestBody}},
+		{name: "multiple objects", giveBody: `{"test": "ok"}{"second": "ok"}`, giveContentType: "application/json", wantErr: &APIError{Err: errors.New("request body must only contain a single JSON object"), Status: http.StatusBadRequest, Code: CodeInvalidRequestBody}},
+		{name: "empty", giveBody: ``, giveContentType: "application/json", wantErr: &APIError{Err: errors.New("request body must not be empty"), Status: http.StatusBadRequest, Code: CodeInvalidRequestBody}},
+		{name: "invalid content type", giveBody: `{"test": "ok"}`, giveContentType: "other", wantErr: &APIError{Err: errors.New("Content-Type header is not application/json"), Status: http.StatusUnsupportedMediaType, Code: CodeUnsupportedMediaType}},
 	}
 
 	for _, tc := range testcases {
@@ -1,4 +1,4 @@
-// package apiout provides utility functions to handle API response.
+// Package apiout provides utility functions to handle API response.
 package apiout
 
 import (
@@ -18,51 +18,24 @@ func JSON(ctx context.Context, w http.ResponseWriter, data any, statusCode int)
 
 	jsonData, err := json.Marshal(data)
 	if err != nil {
-		slog.Error("marshalling json", err)
+		slog.ErrorContext(ctx, "marshalling json", "err", err)
 	}
 
 	if _, err := w.Write(jsonData); err != nil {
-		slog.Error("writing response", err)
+		slog.ErrorContext(ctx, "writing response", "err", err)
 	}
 }
 
-type ErrorResponse struct {
-	Error  string            `json:"error"`
-	Fields map[string]string `json:"fields,omitempty"`
-}
-
+// Error will write the error as RFC 7807 problem details. See NewProblem for how errors are mapped.
 func Error(ctx context.Context, w http.ResponseWriter, err error) {
-	var er ErrorResponse
-	var aerr *APIError
-	switch {
-	case IsBadRequest(err):
-		er = ErrorResponse{
-			Error: err.Error(),
-		}
-		w.WriteHeader(http.StatusBadRequest)
-	case errors.As(err, &aerr):
-		er = ErrorResponse{
-			Error: err.Error(),
-		}
-		w.WriteHeader(aerr.Status)
-	default:
-		er = ErrorResponse{
-			Error: http.StatusText(http.StatusInternalServerError),
-		}
-		w.WriteHeader(http.StatusInternalServerError)
-	}
-
-	err = json.NewEncoder(w).Encode(er)
-	if err != nil {
-		slog.Error("failed to encode", "err", err.Error())
-	}
+	WriteProblem(w, NewProblem(ctx, err))
 }
 
 // DecodeJSONBody decodes a JSON body and returns client-friendly errors.
 func DecodeJSONBody(w http.ResponseWriter, r *http.Request, dst interface{}) error {
 	if r.Header.Get("Content-Type") != "application/json" {
 		err := errors.New("Content-Type header is not application/json")
-		return NewRequestError(err, http.StatusUnsupportedMediaType)
+		return NewCodedError(CodeUnsupportedMediaType, err)
 	}
 
 	r.Body = http.MaxBytesReader(w, r.Body, 1048576)
@@ -78,28 +51,28 @@ func DecodeJSONBody(w http.ResponseWriter, r *http.Request, dst interface{}) err
 		switch {
 		case errors.As(err, &syntaxError):
 			err := fmt.Errorf("request body contains badly-formed JSON (at position %d)", syntaxError.Offset)
-			return NewRequestError(err, http.StatusBadRequest)
+			return NewCodedError(CodeInvalidRequestBody, err)
 
 		case errors.Is(err, io.ErrUnexpectedEOF):
 			err := fmt.Errorf("request body contains badly-formed JSON")
-			return NewRequestError(err, http.StatusBadRequest)
+			return NewCodedError(CodeInvalidRequestBody, err)
 
 		case errors.As(err, &unmarshalTypeError):
 			err := fmt.Errorf("request body contains an invalid value for the %q field (at position %d)", unmarshalTypeError.Field, unmarshalTypeError.Offset)
-			return NewRequestError(err, http.StatusBadRequest)
+			return NewFieldError(CodeInvalidRequestBody, unmarshalTypeError.Field, err)
 
 		case strings.HasPrefix(err.Error(), "json: unknown field "):
 			fieldName := strings.TrimPrefix(err.Error(), "json: unknown field ")
 			err := fmt.Errorf("request body contains unknown field %s", fieldName)
-			return NewRequestError(err, http.StatusBadRequest)
+			return NewFieldError(CodeInvalidRequestBody, strings.Trim(fieldName, `"`), err)
 
 		case errors.Is(err, io.EOF):
 			err := errors.New("request body must not be empty")
-			return NewRequestError(err, http.StatusBadRequest)
+			return NewCodedError(CodeInvalidRequestBody, err)
 
 		case err.Error() == "http: request body too large":
 			err := errors.New("request body must not be larger than 1MB")
-			return NewRequestError(err, http.StatusRequestEntityTooLarge)
+			return NewCodedError(CodeRequestBodyTooLarge, err)
 
 		default:
 			return err
@@ -109,7 +82,7 @@ func DecodeJSONBody(w http.ResponseWriter, r *http.Request, dst interface{}) err
 	err = dec.Decode(&struct{}{})
 	if err != io.EOF {
 		err := errors.New("request body must only contain a single JSON object")
-		return NewRequestError(err, http.StatusBadRequest)
+		return NewCodedError(CodeInvalidRequestBody, err)
 	}
 
 	return nil
@@ -0,0 +1,118 @@
+package apiout
+
+import "net/http"
+
+// ErrorCode is the machine readable identifier of an error returned by the API.
+// Codes are stable, thus clients should match on the code rather than the error detail.
+type ErrorCode string
+
+const (
+	CodeInternal             ErrorCode = "internal_error"
+	CodeBadRequest           ErrorCode = "bad_request"
+	CodeNotFound             ErrorCode = "not_found"
+	CodeMethodNotAllowed     ErrorCode = "method_not_allowed"
+	CodeValidationFailed     ErrorCode = "validation_failed"
+	CodeInvalidRequestBody   ErrorCode = "invalid_request_body"
+	CodeUnsupportedMediaType ErrorCode = "unsupported_media_type"
+	CodeRequestBodyTooLarge  ErrorCode = "request_body_too_large"
+	CodeNotAcceptable        ErrorCode = "not_acceptable"
+
+	CodeInvalidTransactionId   ErrorCode = "invalid_transaction_id"
+	CodeTransactionNotFound    ErrorCode = "transaction_not_found"
+	CodeInvalidAmount          ErrorCode = "invalid_amount"
+	CodeInvalidCurrency        ErrorCode = "invalid_currency"
+	CodeInvalidTransactionDate ErrorCode = "invalid_transaction_date"
+	CodeInvalidQueryParameter  ErrorCode = "invalid_query_parameter"
+	CodeInvalidCursor          ErrorCode = "invalid_cursor"
+
+	CodeInvalidConversionTarget ErrorCode = "invalid_conversion_target"
+	CodeExchangeRateNotFound    ErrorCode = "exchange_rate_not_found"
+	CodeExchangeRateUnavailable ErrorCode = "exchange_rate_unavailable"
+	// CodeExchangeRateServiceUnavailable is returned without calling the exchange rate service while it is failing
+	CodeExchangeRateServiceUnavailable ErrorCode = "exchange_rate_service_unavailable"
+
+	CodeIdempotencyKeyReused     ErrorCode = "idempotency_key_reused"
+	CodeIdempotencyKeyInProgress ErrorCode = "idempotency_key_in_progress"
+)
+
+// ProblemTypeBaseURI is prefixed to the error code to build the RFC 7807 problem type of the error.
+const ProblemTypeBaseURI = "urn:wex-tag:problem:"
+
+type errorDefinition struct {
+	status int
+	title  string
+}
+
+// errorCatalog holds the HTTP status and human readable title of every error code returned by the API.
+var errorCatalog = map[ErrorCode]errorDefinition{
+	CodeInternal:             {http.StatusInternalServerError, "Internal server error"},
+	CodeBadRequest:           {http.StatusBadRequest, "Bad request"},
+	CodeNotFound:             {http.StatusNotFound, "Resource not found"},
+	CodeMethodNotAllowed:     {http.StatusMethodNotAllowed, "Method not allowed"},
+	CodeValidationFailed:     {http.StatusBadRequest, "Request validation failed"},
+	CodeInvalidRequestBody:   {http.StatusBadRequest, "Invalid request body"},
+	CodeUnsupportedMediaType: {http.StatusUnsupportedMediaType, "Unsupported media type"},
+	CodeRequestBodyTooLarge:  {http.StatusRequestEntityTooLarge, "Request body too large"},
+	CodeNotAcceptable:        {http.StatusNotAcceptable, "Not acceptable"},
+
+	CodeInvalidTransactionId:   {http.StatusBadRequest, "Invalid transaction id"},
+	CodeTransactionNotFound:    {http.StatusNotFound, "Transaction not found"},
+	CodeInvalidAmount:          {http.StatusBadRequest, "Invalid amount"},
+	CodeInvalidCurrency:        {http.StatusBadRequest, "Invalid currency"},
+	CodeInvalidTransactionDate: {http.StatusBadRequest, "Invalid transaction date"},
+	CodeInvalidQueryParameter:  {http.StatusBadRequest, "Invalid query parameter"},
+	CodeInvalidCursor:          {http.StatusBadRequest, "Invalid cursor"},
+
+	CodeInvalidConversionTarget: {http.StatusBadRequest, "Invalid conversion target"},
+	CodeExchangeRateNotFound:    {http.StatusBadRequest, "Exchange rate not found"},
+	CodeExchangeRateUnavailable: {http.StatusInternalServerError, "Exchange rate unavailable"},
+
+	CodeExchangeRateServiceUnavailable: {http.StatusServiceUnavailable, "Exchange rate service unavailable"},
+
+	CodeIdempotencyKeyReused:     {http.StatusUnprocessableEntity, "Idempotency key reused"},
+	CodeIdempotencyKeyInProgress: {http.StatusConflict, "Idempotency key in progress"},
+}
+
+// Status will return the HTTP status registered for the code. Unknown codes are internal server errors.
+func (c ErrorCode) Status() int {
+	if def, ok := errorCatalog[c]; ok {
+		return def.status
+	}
+
+	return http.StatusInternalServerError
+}
+
+// Title will return the human readable summary registered for the code.
+func (c ErrorCode) Title() string {
+	if def, ok := errorCatalog[c]; ok {
+		return def.title
+	}
+
+	return http.StatusText(c.Status())
+}
+
+// Type will return the RFC 7807 problem type of the code.
+func (c ErrorCode) Type() string {
+	return ProblemTypeBaseURI + string(c)
+}
+
+// statusCodes are the generic error codes of the HTTP statuses the API responds with.
+var statusCodes = map[int]ErrorCode{
+	http.StatusBadRequest:            CodeBadRequest,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusMethodNotAllowed:      CodeMethodNotAllowed,
+	http.StatusNotAcceptable:         CodeNotAcceptable,
+	http.StatusRequestEntityTooLarge: CodeRequestBodyTooLarge,
+	http.StatusUnsupportedMediaType:  CodeUnsupportedMediaType,
+	http.StatusInternalServerError:   CodeInternal,
+}
+
+// CodeForStatus will return the generic error code of the HTTP status, used for errors which were not given a code.
+// e.g. 404 is 'not_found'. Statuses without a generic code in the catalog are 'internal_error'.
+func CodeForStatus(status int) ErrorCode {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+
+	return CodeInternal
+}
@@ -0,0 +1,59 @@
+package apiout
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/eddie023/wex-tag/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeForStatus(t *testing.T) {
+	type testcase struct {
+		name       string
+		giveStatus int
+		want       ErrorCode
+	}
+
+	testcases := []testcase{
+		{name: "bad request", giveStatus: http.StatusBadRequest, want: CodeBadRequest},
+		{name: "not found", giveStatus: http.StatusNotFound, want: CodeNotFound},
+		{name: "internal server error", giveStatus: http.StatusInternalServerError, want: CodeInternal},
+		{name: "method not allowed", giveStatus: http.StatusMethodNotAllowed, want: CodeMethodNotAllowed},
+		{name: "status without generic code", giveStatus: http.StatusTooManyRequests, want: CodeInternal},
+		{name: "unknown status", giveStatus: 999, want: CodeInternal},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, CodeForStatus(tc.giveStatus))
+		})
+	}
+}
+
+// TestErrorCatalogMatchesSpec makes sure every error code of the catalog is documented in openapi.yaml and vice versa.
+func TestErrorCatalogMatchesSpec(t *testing.T) {
+	swagger, err := types.GetSwagger()
+	assert.NoError(t, err)
+
+	documented := map[ErrorCode]bool{}
+	for _, code := range swagger.Components.Schemas["Problem"].Value.Properties["code"].Value.Enum {
+		documented[ErrorCode(code.(string))] = true
+	}
+
+	for code := range errorCatalog {
+		assert.True(t, documented[code], "error code '%s' is not documented in Problem schema", code)
+	}
+
+	for code := range documented {
+		_, ok := errorCatalog[code]
+		assert.True(t, ok, "documented error code '%s' is not in the error catalog", code)
+	}
+}
+
+// TestCodeForStatusInCatalog makes sure every generic error code is in the error catalog with the same status.
+func TestCodeForStatusInCatalog(t *testing.T) {
+	for status, code := range statusCodes {
+		assert.Equal(t, status, code.Status(), "error code '%s'", code)
+	}
+}
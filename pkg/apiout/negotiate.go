@@ -0,0 +1,185 @@
+package apiout
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	MediaTypeJSON = "application/json"
+	MediaTypeXML  = "application/xml"
+	MediaTypeCSV  = "text/csv"
+)
+
+// ResponseMediaTypes are the media types responses can be written in, ordered by preference.
+var ResponseMediaTypes = []string{MediaTypeJSON, MediaTypeXML, MediaTypeCSV}
+
+// mediaTypeAliases maps the media types clients commonly request to the ones we respond with.
+var mediaTypeAliases = map[string]string{
+	"text/xml": MediaTypeXML,
+}
+
+// CSVMarshaler is implemented by responses which can be written as CSV. The first record is the header.
+type CSVMarshaler interface {
+	MarshalCSV() ([][]string, error)
+}
+
+type mediaRange struct {
+	mediaType string
+	subtype   string
+	quality   float64
+}
+
+// Negotiate will return the offered media type which best matches the Accept header of the request. Offers are
+// ordered by preference, thus the first offer is returned when the client accepts any media type.
+func Negotiate(r *http.Request, offers ...string) (string, error) {
+	accept := strings.TrimSpace(r.Header.Get("Accept"))
+	if accept == "" {
+		return offers[0], nil
+	}
+
+	ranges := parseAccept(accept)
+
+	var best string
+	var bestQuality float64
+
+	for _, offer := range offers {
+		quality := getQuality(ranges, offer)
+		if quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+
+	if best == "" {
+		err := fmt.Errorf("none of the accepted media types '%s' is supported, supported media types are %s", accept, strings.Join(offers, ", "))
+		return "", NewCodedError(CodeNotAcceptable, err)
+	}
+
+	return best, nil
+}
+
+// parseAccept will parse the media ranges of the Accept header. Malformed media ranges are ignored.
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		if alias, ok := mediaTypeAliases[mediaType]; ok {
+			mediaType = alias
+		}
+
+		typ, subtype, ok := strings.Cut(mediaType, "/")
+		if !ok {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			quality, err = strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+		}
+
+		ranges = append(ranges, mediaRange{mediaType: typ, subtype: subtype, quality: quality})
+	}
+
+	return ranges
+}
+
+// getQuality will return the quality of the most specific media range matching the offer, e.g. 'application/xml'
+// takes precedence over 'application/*' which takes precedence over '*/*'.
+func getQuality(ranges []mediaRange, offer string) float64 {
+	typ, subtype, _ := strings.Cut(offer, "/")
+
+	quality := 0.0
+	specificity := -1
+
+	for _, r := range ranges {
+		var s int
+
+		switch {
+		case r.mediaType == typ && r.subtype == subtype:
+			s = 2
+		case r.mediaType == typ && r.subtype == "*":
+			s = 1
+		case r.mediaType == "*" && r.subtype == "*":
+			s = 0
+		default:
+			continue
+		}
+
+		if s > specificity {
+			quality, specificity = r.quality, s
+		}
+	}
+
+	return quality
+}
+
+// Respond will write the data in the given media type, which should be negotiated using Negotiate before processing the request.
+func Respond(ctx context.Context, w http.ResponseWriter, mediaType string, data any, statusCode int) {
+	switch mediaType {
+	case MediaTypeXML:
+		XML(ctx, w, data, statusCode)
+	case MediaTypeCSV:
+		CSV(ctx, w, data, statusCode)
+	default:
+		JSON(ctx, w, data, statusCode)
+	}
+}
+
+func XML(ctx context.Context, w http.ResponseWriter, data any, statusCode int) {
+	xmlData, err := xml.Marshal(data)
+	if err != nil {
+		slog.ErrorContext(ctx, "marshalling xml", "err", err)
+		Error(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", MediaTypeXML)
+	w.WriteHeader(statusCode)
+
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		slog.ErrorContext(ctx, "writing response", "err", err)
+		return
+	}
+
+	if _, err := w.Write(xmlData); err != nil {
+		slog.ErrorContext(ctx, "writing response", "err", err)
+	}
+}
+
+// CSV will write the data as CSV. The data must implement CSVMarshaler.
+func CSV(ctx context.Context, w http.ResponseWriter, data any, statusCode int) {
+	m, ok := data.(CSVMarshaler)
+	if !ok {
+		Error(ctx, w, fmt.Errorf("%T cannot be written as csv", data))
+		return
+	}
+
+	records, err := m.MarshalCSV()
+	if err != nil {
+		slog.ErrorContext(ctx, "marshalling csv", "err", err)
+		Error(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", MediaTypeCSV)
+	w.WriteHeader(statusCode)
+
+	if err := csv.NewWriter(w).WriteAll(records); err != nil {
+		slog.ErrorContext(ctx, "writing response", "err", err)
+	}
+}
@@ -0,0 +1,96 @@
+package apiout
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	type testcase struct {
+		name       string
+		giveAccept string
+		want       string
+		wantErr    bool
+	}
+
+	testcases := []testcase{
+		{name: "no accept header", giveAccept: "", want: MediaTypeJSON},
+		{name: "any media type", giveAccept: "*/*", want: MediaTypeJSON},
+		{name: "exact media type", giveAccept: "application/xml", want: MediaTypeXML},
+		{name: "csv", giveAccept: "text/csv", want: MediaTypeCSV},
+		{name: "text xml alias", giveAccept: "text/xml", want: MediaTypeXML},
+		{name: "media type with parameters", giveAccept: "application/xml; charset=utf-8", want: MediaTypeXML},
+		{name: "highest quality wins", giveAccept: "application/json;q=0.5, application/xml;q=0.9", want: MediaTypeXML},
+		{name: "subtype wildcard", giveAccept: "text/*", want: MediaTypeCSV},
+		{name: "specific range takes precedence over wildcard", giveAccept: "application/json;q=0, */*", want: MediaTypeXML},
+		{name: "browser accept header", giveAccept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", want: MediaTypeXML},
+		{name: "malformed ranges are ignored", giveAccept: "invalid, application/xml", want: MediaTypeXML},
+		{name: "unsupported media type", giveAccept: "image/png", wantErr: true},
+		{name: "every offer excluded", giveAccept: "application/*;q=0, text/*;q=0", wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/purchase", nil)
+			if tc.giveAccept != "" {
+				r.Header.Set("Accept", tc.giveAccept)
+			}
+
+			got, err := Negotiate(r, ResponseMediaTypes...)
+			if tc.wantErr {
+				var aerr *APIError
+				assert.ErrorAs(t, err, &aerr)
+				assert.Equal(t, http.StatusNotAcceptable, aerr.Status)
+				assert.Equal(t, CodeNotAcceptable, aerr.Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+type testRecord struct {
+	Name string `xml:"name"`
+}
+
+func (r testRecord) MarshalCSV() ([][]string, error) {
+	return [][]string{{"name"}, {r.Name}}, nil
+}
+
+func TestRespond(t *testing.T) {
+	type testcase struct {
+		name            string
+		giveMediaType   string
+		giveData        any
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}
+
+	testcases := []testcase{
+		{name: "json", giveMediaType: MediaTypeJSON, giveData: map[string]string{"name": "foo"}, wantStatus: http.StatusOK, wantContentType: MediaTypeJSON, wantBody: `{"name":"foo"}`},
+		{name: "xml", giveMediaType: MediaTypeXML, giveData: testRecord{Name: "foo"}, wantStatus: http.StatusOK, wantContentType: MediaTypeXML, wantBody: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<testRecord><name>foo</name></testRecord>`},
+		{name: "csv", giveMediaType: MediaTypeCSV, giveData: testRecord{Name: "foo, bar"}, wantStatus: http.StatusOK, wantContentType: MediaTypeCSV, wantBody: "name\n\"foo, bar\"\n"},
+		{name: "data without csv support", giveMediaType: MediaTypeCSV, giveData: map[string]string{"name": "foo"}, wantStatus: http.StatusInternalServerError, wantContentType: ProblemContentType},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			Respond(context.TODO(), w, tc.giveMediaType, tc.giveData, http.StatusOK)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			assert.Equal(t, tc.wantContentType, w.Header().Get("Content-Type"))
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package apiout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+// ProblemContentType is the media type of the RFC 7807 problem details error responses.
+const ProblemContentType = "application/problem+json"
+
+// Problem is the RFC 7807 problem details body of every error response.
+type Problem struct {
+	Type     string    `json:"type"`
+	Title    string    `json:"title"`
+	Status   int       `json:"status"`
+	Detail   string    `json:"detail,omitempty"`
+	Instance string    `json:"instance,omitempty"`
+	Code     ErrorCode `json:"code"`
+	// InvalidParams are the request fields which failed validation along with the reason.
+	InvalidParams []InvalidParam `json:"invalidParams,omitempty"`
+	// Errors are the violations of the OpenAPI specification found by the request validator.
+	Errors []Violation `json:"errors,omitempty"`
+}
+
+// InvalidParam describes why a single request field failed validation.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Violation describes why a request failed validation against the OpenAPI specification.
+type Violation struct {
+	// In is the location of the parameter, either path, query, header or cookie, or body for request body fields
+	In string `json:"in,omitempty"`
+	// Name is the name of the parameter or the JSON pointer of the request body field
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+type instanceKey struct{}
+
+// ProblemInstance is a middleware which records the request path as the instance of problem details written during the request.
+func ProblemInstance(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), instanceKey{}, r.URL.Path)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// NewProblem will map the error into problem details. Errors other than APIError and BadRequestErr are
+// reported as internal server error without leaking details to the client.
+func NewProblem(ctx context.Context, err error) Problem {
+	var p Problem
+	var aerr *APIError
+
+	switch {
+	case IsBadRequest(err):
+		p = newProblem(CodeBadRequest, http.StatusBadRequest, err.Error())
+	case errors.As(err, &aerr):
+		p = newProblem(aerr.GetCode(), aerr.Status, err.Error())
+		p.InvalidParams = aerr.Fields
+		p.Errors = aerr.Violations
+	default:
+		slog.ErrorContext(ctx, "unexpected error while processing request", "err", err)
+		p = newProblem(CodeInternal, http.StatusInternalServerError, "")
+	}
+
+	p.Instance, _ = ctx.Value(instanceKey{}).(string)
+
+	return p
+}
+
+func newProblem(code ErrorCode, status int, detail string) Problem {
+	title := http.StatusText(status)
+	if _, ok := errorCatalog[code]; ok {
+		title = code.Title()
+	}
+
+	return Problem{
+		Type:   code.Type(),
+		Title:  title,
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// WriteProblem will write the problem details with the problem+json content type.
+func WriteProblem(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+
+	err := json.NewEncoder(w).Encode(p)
+	if err != nil {
+		slog.Error("failed to encode", "err", err.Error())
+	}
+}
+
+// RequestValidationError writes the failures of the OpenAPI request validator as problem details.
+func RequestValidationError(ctx context.Context, w http.ResponseWriter, message string, statusCode int) {
+	code := CodeForStatus(statusCode)
+	if statusCode == http.StatusBadRequest {
+		code = CodeValidationFailed
+	}
+
+	p := newProblem(code, statusCode, message)
+	p.Instance, _ = ctx.Value(instanceKey{}).(string)
+
+	WriteProblem(w, p)
+}
+
+// NotFound writes the problem details of requests made to unknown routes.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	Error(r.Context(), w, NewCodedError(CodeNotFound, errors.New("no matching operation was found")))
+}
+
+// MethodNotAllowed writes the problem details of requests made with an unsupported method.
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	Error(r.Context(), w, NewRequestError(errors.New("method not allowed"), http.StatusMethodNotAllowed))
+}
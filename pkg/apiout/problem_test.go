@@ -0,0 +1,128 @@
+package apiout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError(t *testing.T) {
+	type testcase struct {
+		name    string
+		giveErr error
+		want    Problem
+	}
+
+	testcases := []testcase{
+		{
+			name:    "coded error with field",
+			giveErr: NewFieldError(CodeInvalidAmount, "amount", errors.New("amount cannot be negative number")),
+			want: Problem{
+				Type:          "urn:wex-tag:problem:invalid_amount",
+				Title:         "Invalid amount",
+				Status:        http.StatusBadRequest,
+				Detail:        "amount cannot be negative number",
+				Instance:      "/purchase",
+				Code:          CodeInvalidAmount,
+				InvalidParams: []InvalidParam{{Name: "amount", Reason: "amount cannot be negative number"}},
+			},
+		},
+		{
+			name:    "request error without code",
+			giveErr: NewRequestError(errors.New("method not allowed"), http.StatusMethodNotAllowed),
+			want: Problem{
+				Type:     "urn:wex-tag:problem:method_not_allowed",
+				Title:    "Method not allowed",
+				Status:   http.StatusMethodNotAllowed,
+				Detail:   "method not allowed",
+				Instance: "/purchase",
+				Code:     CodeMethodNotAllowed,
+			},
+		},
+		{
+			name:    "bad request",
+			giveErr: BadRequest("invalid input"),
+			want: Problem{
+				Type:     "urn:wex-tag:problem:bad_request",
+				Title:    "Bad request",
+				Status:   http.StatusBadRequest,
+				Detail:   "invalid input",
+				Instance: "/purchase",
+				Code:     CodeBadRequest,
+			},
+		},
+		{
+			name:    "unexpected error does not leak details",
+			giveErr: errors.New("pq: connection refused"),
+			want: Problem{
+				Type:     "urn:wex-tag:problem:internal_error",
+				Title:    "Internal server error",
+				Status:   http.StatusInternalServerError,
+				Instance: "/purchase",
+				Code:     CodeInternal,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := ProblemInstance(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Error(r.Context(), w, tc.giveErr)
+			}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/purchase", nil))
+
+			assert.Equal(t, tc.want.Status, w.Code)
+			assert.Equal(t, ProblemContentType, w.Header().Get("Content-Type"))
+
+			var got Problem
+			err := json.NewDecoder(w.Body).Decode(&got)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewProblemWithoutInstance(t *testing.T) {
+	got := NewProblem(context.TODO(), NewCodedError(CodeTransactionNotFound, errors.New("given transaction id not found")))
+
+	assert.Equal(t, "", got.Instance)
+	assert.Equal(t, http.StatusNotFound, got.Status)
+	assert.Equal(t, CodeTransactionNotFound, got.Code)
+}
+
+func TestRequestValidationError(t *testing.T) {
+	type testcase struct {
+		name       string
+		giveStatus int
+		wantCode   ErrorCode
+	}
+
+	testcases := []testcase{
+		{name: "invalid request", giveStatus: http.StatusBadRequest, wantCode: CodeValidationFailed},
+		{name: "unknown route", giveStatus: http.StatusNotFound, wantCode: CodeNotFound},
+		{name: "unexpected validator failure", giveStatus: http.StatusInternalServerError, wantCode: CodeInternal},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			RequestValidationError(context.TODO(), w, "validation message", tc.giveStatus)
+
+			var got Problem
+			err := json.NewDecoder(w.Body).Decode(&got)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.giveStatus, w.Code)
+			assert.Equal(t, tc.wantCode, got.Code)
+			assert.Equal(t, "validation message", got.Detail)
+		})
+	}
+}
@@ -18,7 +18,80 @@ type ApiConfig struct {
 		IdleTimeout     time.Duration `conf:"default:120s"`
 		ShutdownTimeout time.Duration `conf:"default:20s"`
 		Host            string        `conf:"default:0.0.0.0:8000,env:API_HOST"`
-		DebugHost       string        `conf:"default:0.0.0.0:4000"`
+		DebugHost       string        `conf:"default:127.0.0.1:4000"`
+		// IdempotencyKeyTTL is how long an idempotency key is remembered to replay the original response
+		IdempotencyKeyTTL time.Duration `conf:"default:24h"`
+		// IdempotencyKeyLease is how long an idempotency key stays reserved for a request which did not complete, e.g. since the
+		// process crashed, before retries can reclaim it. Keep it above RequestTimeout
+		IdempotencyKeyLease time.Duration `conf:"default:1m"`
+		// IdempotencyKeyCleanupInterval is how often expired idempotency keys are deleted
+		IdempotencyKeyCleanupInterval time.Duration `conf:"default:10m"`
+		// ServeDocs exposes the OpenAPI spec as /openapi.json and /openapi.yaml along with the API docs under /docs
+		ServeDocs bool `conf:"default:true,env:SERVE_DOCS"`
+		// RequestTimeout is the deadline of every API request, which bounds the retries of the exchange rate API calls. Keep it below WriteTimeout
+		RequestTimeout time.Duration `conf:"default:8s"`
+		// ReadinessCheckTimeout is the maximum time each of the /readyz dependency checks is allowed to take
+		ReadinessCheckTimeout time.Duration `conf:"default:2s"`
+		// ShutdownDrainPeriod is how long /readyz reports not ready before the server stops accepting connections
+		ShutdownDrainPeriod time.Duration `conf:"default:5s"`
+	}
+
+	ExchangeRate struct {
+		// Providers are tried in the given order until one of them returns an exchange rate. Supported providers are treasury, ecb and file
+		Providers []string `conf:"default:treasury,env:EXCHANGE_RATE_PROVIDERS"`
+		// TreasuryURL is the base URL of the treasury rates of exchange API
+		TreasuryURL string `conf:"default:https://api.fiscaldata.treasury.gov/services/api/fiscal_service/v1/accounting/od/rates_of_exchange,env:TREASURY_URL"`
+		// TreasuryTimeout is the timeout of a single call to the treasury API, calls which time out are retried
+		TreasuryTimeout time.Duration `conf:"default:10s"`
+		// TreasuryRetryInitialInterval, TreasuryRetryMaxInterval and TreasuryRetryMaxElapsedTime configure the exponential
+		// backoff of calls to the treasury API which were rate limited or failed with a transient error
+		TreasuryRetryInitialInterval time.Duration `conf:"default:500ms"`
+		TreasuryRetryMaxInterval     time.Duration `conf:"default:10s"`
+		TreasuryRetryMaxElapsedTime  time.Duration `conf:"default:1m"`
+		// TreasuryMaxRetries is the maximum number of retries of a call to the treasury API, unlimited within TreasuryRetryMaxElapsedTime when 0
+		TreasuryMaxRetries int `conf:"default:5"`
+		// TreasuryBreakerThreshold is the number of consecutive failed calls after which the treasury API is no longer
+		// called for TreasuryBreakerOpenTimeout, failing fast with 503 instead
+		TreasuryBreakerThreshold   int           `conf:"default:5"`
+		TreasuryBreakerOpenTimeout time.Duration `conf:"default:30s"`
+		// RatesFile is the treasury rates of exchange dataset in JSON or CSV format used by the file provider
+		RatesFile string `conf:"env:EXCHANGE_RATES_FILE"`
+		// ECBSource is the URL or file path of the ECB reference rates XML document used by the ecb provider
+		ECBSource          string        `conf:"default:https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml,env:ECB_RATES_SOURCE"`
+		ECBRefreshInterval time.Duration `conf:"default:12h"`
+		// ECBLoadTimeout bounds downloading and parsing the ECB reference rates
+		ECBLoadTimeout time.Duration `conf:"default:30s"`
+		// CacheSize is the maximum number of treasury exchange rates kept in memory and CacheTTL how long each of them is
+		// served before it is looked up again
+		CacheSize int           `conf:"default:1000"`
+		CacheTTL  time.Duration `conf:"default:1h"`
+		// MaxConcurrentLookups is the maximum number of exchange rates looked up at a time when converting to multiple currencies
+		MaxConcurrentLookups int `conf:"default:4"`
+		// RatePolicy selects the exchange rate of a purchase when the request does not ask for a policy. Supported policies
+		// are latest_on_or_before, nearest, same_quarter and earliest_after
+		RatePolicy string `conf:"default:latest_on_or_before,env:EXCHANGE_RATE_POLICY"`
+		// RateLookbackMonths bounds how far from the purchase date a rate may be recorded, except for the same_quarter policy
+		RateLookbackMonths int `conf:"default:6,env:EXCHANGE_RATE_LOOKBACK_MONTHS"`
+		// ProbeCountry and ProbeCurrency is the exchange rate looked up from the treasury API by /readyz to verify it is available. Leave empty to skip the probe
+		ProbeCountry  string `conf:"env:EXCHANGE_RATE_PROBE_COUNTRY"`
+		ProbeCurrency string `conf:"env:EXCHANGE_RATE_PROBE_CURRENCY"`
+		// ProbeInterval is how long the result of the probe is reused by /readyz before the treasury API is called again
+		ProbeInterval time.Duration `conf:"default:1m"`
+	}
+
+	Money struct {
+		// RoundingMode rounds amounts to the minor units of their currency, either half_up, half_even or down
+		RoundingMode string `conf:"default:half_up,env:ROUNDING_MODE"`
+	}
+
+	Tracing struct {
+		// Exporter of the spans, either none, stdout or otlp
+		Exporter    string `conf:"default:none,env:TRACING_EXPORTER"`
+		ServiceName string `conf:"default:wex-tag"`
+		// OTLPEndpoint is the host and port of the collector receiving spans over OTLP/HTTP
+		OTLPEndpoint string  `conf:"default:localhost:4318,env:TRACING_OTLP_ENDPOINT"`
+		OTLPInsecure bool    `conf:"default:true"`
+		SampleRatio  float64 `conf:"default:1"`
 	}
 
 	Db struct {
@@ -44,7 +117,7 @@ func GetParsedConfig() (*ApiConfig, error) {
 			fmt.Println(help)
 			return nil, err
 		}
-		slog.Error("unable to parse config", err)
+		slog.Error("unable to parse config", "err", err)
 		return nil, err
 	}
 
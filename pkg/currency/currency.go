@@ -0,0 +1,107 @@
+// Package currency maps ISO 3166 country codes and ISO 4217 currency codes to the country and currency descriptors
+// used by the treasury rates of exchange API e.g. 'GB' and 'GBP' to 'United Kingdom' and 'Pound'.
+package currency
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// treasuryCurrencies is the mapping table with the columns country_code, currency_code, country and currency. When a
+// code maps to several descriptors e.g. EUR, the first row of the code is its default.
+//
+//go:embed treasury_currencies.csv
+var treasuryCurrencies string
+
+var (
+	ErrUnknownCountryCode  = errors.New("unknown country code")
+	ErrUnknownCurrencyCode = errors.New("unknown currency code")
+)
+
+// Descriptor is a country and currency pair known by both its ISO codes and its treasury descriptor.
+type Descriptor struct {
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country, 'EU' for the euro zone
+	CountryCode string
+	// CurrencyCode is the ISO 4217 code of the currency
+	CurrencyCode string
+	// Country and Currency are as used by the treasury 'country_currency_desc'
+	Country  string
+	Currency string
+}
+
+// CountryCurrencyDesc will return the descriptor in the treasury 'country_currency_desc' format.
+func (d Descriptor) CountryCurrencyDesc() string {
+	return d.Country + "-" + d.Currency
+}
+
+var descriptors = mustParse(treasuryCurrencies)
+
+// All returns every known descriptor in the order of the mapping table.
+func All() []Descriptor {
+	return append([]Descriptor(nil), descriptors...)
+}
+
+// Resolve will return the descriptor of the country and currency codes, either of which may be empty. A currency code
+// used by several countries resolves to its default country unless the country code is given, while a country code
+// alone resolves to the default currency of the country. Codes are case insensitive.
+func Resolve(countryCode, currencyCode string) (Descriptor, error) {
+	countryCode = strings.ToUpper(strings.TrimSpace(countryCode))
+	currencyCode = strings.ToUpper(strings.TrimSpace(currencyCode))
+
+	if countryCode == "" && currencyCode == "" {
+		return Descriptor{}, errors.New("either country code or currency code must be provided")
+	}
+
+	var countryFound, currencyFound bool
+
+	for _, d := range descriptors {
+		countryMatch := countryCode == "" || d.CountryCode == countryCode
+		currencyMatch := currencyCode == "" || d.CurrencyCode == currencyCode
+
+		countryFound = countryFound || d.CountryCode == countryCode
+		currencyFound = currencyFound || d.CurrencyCode == currencyCode
+
+		if countryMatch && currencyMatch {
+			return d, nil
+		}
+	}
+
+	switch {
+	case countryCode != "" && !countryFound:
+		return Descriptor{}, fmt.Errorf("%w '%s'", ErrUnknownCountryCode, countryCode)
+	case currencyCode != "" && !currencyFound:
+		return Descriptor{}, fmt.Errorf("%w '%s'", ErrUnknownCurrencyCode, currencyCode)
+	default:
+		return Descriptor{}, fmt.Errorf("currency '%s' is not used in country '%s'", currencyCode, countryCode)
+	}
+}
+
+// Lookup will return the descriptor of the treasury country and currency, matched case insensitively.
+func Lookup(country, currency string) (Descriptor, bool) {
+	for _, d := range descriptors {
+		if strings.EqualFold(d.Country, strings.TrimSpace(country)) && strings.EqualFold(d.Currency, strings.TrimSpace(currency)) {
+			return d, true
+		}
+	}
+
+	return Descriptor{}, false
+}
+
+func mustParse(table string) []Descriptor {
+	records, err := csv.NewReader(strings.NewReader(table)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("parsing currency table: %v", err))
+	}
+
+	out := make([]Descriptor, 0, len(records))
+
+	// skip the header
+	for _, record := range records[1:] {
+		out = append(out, Descriptor{CountryCode: record[0], CurrencyCode: record[1], Country: record[2], Currency: record[3]})
+	}
+
+	return out
+}
@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestResolve(t *testing.T) {
+	type testcase struct {
+		name         string
+		countryCode  string
+		currencyCode string
+
+		want    string
+		wantErr error
+	}
+
+	testcases := []testcase{
+		{name: "should resolve currency code", currencyCode: "GBP", want: "United Kingdom-Pound"},
+		{name: "should resolve country code", countryCode: "np", want: "Nepal-Rupee"},
+		{name: "should resolve shared currency code to its default", currencyCode: "EUR", want: "Euro Zone-Euro"},
+		{name: "should resolve shared currency code by country code", countryCode: "DE", currencyCode: "eur", want: "Germany-Euro"},
+		{name: "should fail for unknown currency code", currencyCode: "XXX", wantErr: ErrUnknownCurrencyCode},
+		{name: "should fail for unknown country code", countryCode: "XX", currencyCode: "EUR", wantErr: ErrUnknownCountryCode},
+		{name: "should fail for currency not used in the country", countryCode: "GB", currencyCode: "EUR", wantErr: errors.New("currency 'EUR' is not used in country 'GB'")},
+		{name: "should fail without any code", wantErr: errors.New("either country code or currency code must be provided")},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Resolve(tc.countryCode, tc.currencyCode)
+			if tc.wantErr != nil {
+				assert.Assert(t, err != nil)
+				assert.Assert(t, errors.Is(err, tc.wantErr) || err.Error() == tc.wantErr.Error(), "got error = %v", err)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tc.want, got.CountryCurrencyDesc())
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	got, ok := Lookup("united kingdom", "Pound")
+	assert.Assert(t, ok)
+	assert.Equal(t, "GB", got.CountryCode)
+	assert.Equal(t, "GBP", got.CurrencyCode)
+
+	_, ok = Lookup("Atlantis", "Shell")
+	assert.Assert(t, !ok)
+}
+
+func TestTableHasUniqueDescriptors(t *testing.T) {
+	seen := map[string]bool{}
+
+	for _, d := range All() {
+		assert.Assert(t, len(d.CountryCode) == 2 && len(d.CurrencyCode) == 3, "invalid codes of '%s'", d.CountryCurrencyDesc())
+		assert.Assert(t, !seen[d.CountryCurrencyDesc()], "duplicate descriptor '%s'", d.CountryCurrencyDesc())
+
+		seen[d.CountryCurrencyDesc()] = true
+	}
+}
@@ -1,43 +1,80 @@
 package db
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/eddie023/wex-tag/ent"
 	"github.com/eddie023/wex-tag/ent/enttest"
+	"github.com/eddie023/wex-tag/ent/migrate/migrations"
 	"github.com/eddie023/wex-tag/pkg/config"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 type DB struct {
 	Client *ent.Client
+	// SQL is the connection pool used by the ent client, used for health checks
+	SQL *sql.DB
 }
 
 // Initiate a new db connection
 func NewConnection(cfg *config.ApiConfig) (*DB, error) {
-	client, err := connectDb(cfg)
+	connectionURL := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", cfg.Db.Host, cfg.Db.Port, cfg.Db.User, cfg.Db.Dbname, cfg.Db.Password)
+
+	slog.Debug("connecting to db", "database", "postgres", "connection-url", connectionURL)
+
+	drv, err := entsql.Open(dialect.Postgres, connectionURL)
 	if err != nil {
 		return nil, err
 	}
 
+	client := ent.NewClient(ent.Driver(drv))
+	Trace(client)
+
 	return &DB{
 		Client: client,
+		SQL:    drv.DB(),
 	}, nil
 }
 
-func connectDb(cfg *config.ApiConfig) (*ent.Client, error) {
-	connectionURL := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", cfg.Db.Host, cfg.Db.Port, cfg.Db.User, cfg.Db.Dbname, cfg.Db.Password)
+// Ping verifies the connection to the database is still alive.
+func (d *DB) Ping(ctx context.Context) error {
+	return d.SQL.PingContext(ctx)
+}
 
-	slog.Debug("connecting to db", "database", "postgres", "connection-url", connectionURL)
+// CheckMigrationVersion verifies the database is migrated to the latest migration embedded in the binary.
+func (d *DB) CheckMigrationVersion(ctx context.Context) error {
+	want, err := migrations.LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	var version uint
+	var dirty bool
 
-	client, err := ent.Open("postgres", connectionURL)
+	err = d.SQL.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("database is not migrated, want version %d", want)
+	}
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("migration version %d is dirty", version)
+	}
+
+	if version != want {
+		return fmt.Errorf("database is at migration version %d, want %d", version, want)
 	}
 
-	return client, nil
+	return nil
 }
 
 // CreateTestDatabase creates a dummy sqlite3 ent client which can be used for writing test purposes.
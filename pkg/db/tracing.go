@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+
+	entgo "entgo.io/ent"
+	"github.com/eddie023/wex-tag/ent"
+	"github.com/eddie023/wex-tag/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Trace will create a span for every ent query and mutation made by the client.
+func Trace(client *ent.Client) {
+	client.Intercept(queryTracer())
+	client.Use(mutationTracer)
+}
+
+// queryTracer will name the query spans by the queried type and operation e.g. 'ent.Transaction.All'.
+func queryTracer() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			name := "ent.query"
+			attrs := []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+			if qc := entgo.QueryFromContext(ctx); qc != nil {
+				name = "ent." + qc.Type + "." + qc.Op
+				attrs = append(attrs, attribute.String("db.sql.table", qc.Type), attribute.String("db.operation", qc.Op))
+			}
+
+			ctx, span := tracing.Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			defer span.End()
+
+			v, err := next.Query(ctx, q)
+			tracing.RecordError(span, err)
+
+			return v, err
+		})
+	})
+}
+
+// mutationTracer will name the mutation spans by the mutated type and operation e.g. 'ent.Transaction.OpCreate'.
+func mutationTracer(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		ctx, span := tracing.Tracer().Start(ctx, "ent."+m.Type()+"."+m.Op().String(),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system", "postgresql"),
+				attribute.String("db.sql.table", m.Type()),
+				attribute.String("db.operation", m.Op().String()),
+			),
+		)
+		defer span.End()
+
+		v, err := next.Mutate(ctx, m)
+		tracing.RecordError(span, err)
+
+		return v, err
+	})
+}
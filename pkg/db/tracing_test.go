@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gotest.tools/assert"
+)
+
+func TestTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	client := CreateTestDatabase(t)
+	defer client.Close()
+
+	Trace(client)
+
+	ctx := context.Background()
+
+	_, err := client.Transaction.Create().SetAmountInUsd(decimal.NewFromInt(10)).SetDate(time.Now()).SetDescription("foo").Save(ctx)
+	assert.NilError(t, err)
+
+	_, err = client.Transaction.Query().All(ctx)
+	assert.NilError(t, err)
+
+	var names []string
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+	}
+
+	assert.DeepEqual(t, []string{"ent.Transaction.OpCreate", "ent.Transaction.All"}, names)
+}
@@ -0,0 +1,26 @@
+// Package debug provides the handler of the debug server which must not be exposed publicly.
+package debug
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+
+	"github.com/eddie023/wex-tag/pkg/metrics"
+)
+
+// Mux will serve the pprof profiles under '/debug/pprof/', the expvar variables under '/debug/vars' and the
+// Prometheus metrics under '/metrics'. Handlers are registered explicitly instead of relying on http.DefaultServeMux.
+func Mux() *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.Handle("/metrics", metrics.Handler())
+
+	return mux
+}
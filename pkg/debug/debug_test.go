@@ -0,0 +1,51 @@
+package debug
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestMux(t *testing.T) {
+	type testcase struct {
+		name string
+		path string
+
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "should serve pprof index",
+			path:     "/debug/pprof/",
+			wantBody: "goroutine",
+		},
+		{
+			name:     "should serve expvar variables",
+			path:     "/debug/vars",
+			wantBody: `"memstats"`,
+		},
+		{
+			name:     "should serve prometheus metrics",
+			path:     "/metrics",
+			wantBody: "go_goroutines",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+
+			Mux().ServeHTTP(rr, httptest.NewRequest("GET", tc.path, nil))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+
+			if !strings.Contains(rr.Body.String(), tc.wantBody) {
+				t.Errorf("want =%s got =%s", tc.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
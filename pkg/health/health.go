@@ -0,0 +1,143 @@
+// Package health reports whether the service is ready to receive traffic.
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCheckTimeout is used when the checker has no timeout configured.
+const DefaultCheckTimeout = 2 * time.Second
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+
+	StatusReady        = "ready"
+	StatusNotReady     = "not_ready"
+	StatusShuttingDown = "shutting_down"
+)
+
+// Check is a single dependency which has to be available for the service to be ready.
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type CheckResult struct {
+	Status string `json:"status"`
+	// LatencyMs is how long the check took in milliseconds
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Ready reports whether every check succeeded.
+func (r Report) Ready() bool {
+	return r.Status == StatusReady
+}
+
+// Cached will run the check at most once every interval and report its last result in between, e.g. for checks calling
+// an upstream API which should not be called on every readiness probe.
+func Cached(check func(ctx context.Context) error, interval time.Duration) func(ctx context.Context) error {
+	var mu sync.Mutex
+	var checkedAt time.Time
+	var last error
+
+	return func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !checkedAt.IsZero() && time.Since(checkedAt) < interval {
+			return last
+		}
+
+		last = check(ctx)
+		checkedAt = time.Now()
+
+		return last
+	}
+}
+
+// Checker runs all of the checks concurrently and reports the service as not ready once it is shutting down.
+type Checker struct {
+	Checks []Check
+	// Timeout is the maximum time a single check is allowed to take
+	Timeout time.Duration
+
+	shuttingDown atomic.Bool
+}
+
+// Shutdown marks the service as shutting down so load balancers stop routing new requests to it.
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+func (c *Checker) Run(ctx context.Context) Report {
+	report := Report{
+		Status: StatusReady,
+		Checks: make(map[string]CheckResult, len(c.Checks)),
+	}
+
+	if c.shuttingDown.Load() {
+		report.Status = StatusShuttingDown
+
+		return report
+	}
+
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultCheckTimeout
+	}
+
+	results := make([]CheckResult, len(c.Checks))
+
+	var wg sync.WaitGroup
+	for i, check := range c.Checks {
+		wg.Add(1)
+
+		go func(i int, check Check) {
+			defer wg.Done()
+
+			results[i] = runCheck(ctx, check, timeout)
+		}(i, check)
+	}
+	wg.Wait()
+
+	for i, check := range c.Checks {
+		if results[i].Status != StatusOK {
+			report.Status = StatusNotReady
+		}
+
+		report.Checks[check.Name] = results[i]
+	}
+
+	return report
+}
+
+func runCheck(ctx context.Context, check Check, timeout time.Duration) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Check(ctx)
+	latency := time.Since(start)
+
+	result := CheckResult{
+		Status:    StatusOK,
+		LatencyMs: float64(latency.Microseconds()) / 1000,
+	}
+
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+
+	return result
+}
@@ -0,0 +1,102 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestCheckerRun(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         []Check
+		giveShutdown bool
+
+		wantStatus string
+		wantChecks map[string]string
+		wantErrors map[string]string
+	}
+
+	ok := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return errors.New("connection refused") }
+	slow := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	testcases := []testcase{
+		{
+			name:       "should be ready when all checks succeed",
+			give:       []Check{{Name: "database", Check: ok}, {Name: "migrations", Check: ok}},
+			wantStatus: StatusReady,
+			wantChecks: map[string]string{"database": StatusOK, "migrations": StatusOK},
+		},
+		{
+			name:       "should not be ready when any check fails",
+			give:       []Check{{Name: "database", Check: fail}, {Name: "migrations", Check: ok}},
+			wantStatus: StatusNotReady,
+			wantChecks: map[string]string{"database": StatusFail, "migrations": StatusOK},
+			wantErrors: map[string]string{"database": "connection refused"},
+		},
+		{
+			name:       "should fail checks which take longer than the timeout",
+			give:       []Check{{Name: "exchangeRate", Check: slow}},
+			wantStatus: StatusNotReady,
+			wantChecks: map[string]string{"exchangeRate": StatusFail},
+			wantErrors: map[string]string{"exchangeRate": "context deadline exceeded"},
+		},
+		{
+			name:         "should not be ready while shutting down",
+			give:         []Check{{Name: "database", Check: ok}},
+			giveShutdown: true,
+			wantStatus:   StatusShuttingDown,
+			wantChecks:   map[string]string{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := &Checker{Checks: tc.give, Timeout: 10 * time.Millisecond}
+			if tc.giveShutdown {
+				checker.Shutdown()
+			}
+
+			report := checker.Run(context.Background())
+
+			assert.Equal(t, tc.wantStatus, report.Status)
+			assert.Equal(t, tc.wantStatus == StatusReady, report.Ready())
+			assert.Equal(t, len(tc.wantChecks), len(report.Checks))
+
+			for name, status := range tc.wantChecks {
+				assert.Equal(t, status, report.Checks[name].Status)
+				assert.Equal(t, tc.wantErrors[name], report.Checks[name].Error)
+			}
+		})
+	}
+}
+
+func TestCached(t *testing.T) {
+	var calls int
+	check := Cached(func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return errors.New("connection refused")
+		}
+
+		return nil
+	}, 10*time.Millisecond)
+
+	// the failure should be reported until the interval passed, without running the check again
+	for i := 0; i < 3; i++ {
+		assert.Error(t, check(context.TODO()), "connection refused")
+	}
+	assert.Equal(t, 1, calls)
+
+	time.Sleep(20 * time.Millisecond)
+
+	assert.NilError(t, check(context.TODO()))
+	assert.Equal(t, 2, calls)
+}
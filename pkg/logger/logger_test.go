@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceHandler(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanCtx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+
+	type testcase struct {
+		name string
+		give context.Context
+
+		want string
+	}
+
+	testcases := []testcase{
+		{
+			name: "should add trace and span id of the span in context",
+			give: spanCtx,
+			want: "msg=hello foo=bar trace_id=4bf92f3577b34da6a3ce929d0e0e4736 span_id=00f067aa0ba902b7",
+		},
+		{
+			name: "should leave records without span as is",
+			give: context.Background(),
+			want: "msg=hello foo=bar\n",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(NewTraceHandler(slog.NewTextHandler(&buf, nil))).With("foo", "bar")
+
+			logger.InfoContext(tc.give, "hello")
+
+			if !strings.HasSuffix(strings.TrimSuffix(buf.String(), "\n"), strings.TrimSuffix(tc.want, "\n")) {
+				t.Errorf("want =%s got =%s", tc.want, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Package metrics holds the Prometheus collectors of the service, which are exposed on the debug server.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "wex_tag"
+
+// Registry holds every collector of the service along with the Go runtime and process collectors.
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests by OpenAPI operation id and response status code.",
+	}, []string{"operation", "code"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests by OpenAPI operation id.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	TreasuryRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "treasury_request_duration_seconds",
+		Help:      "Latency of every call made to the Treasury rates of exchange API by response status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"code"})
+
+	TreasuryRetries = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "treasury_retries_total",
+		Help:      "Number of calls to the Treasury rates of exchange API which were retried after a backoff.",
+	})
+
+	TreasuryRateLimited = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "treasury_rate_limited_total",
+		Help:      "Number of 429 Too Many Requests responses returned by the Treasury rates of exchange API.",
+	})
+
+	CircuitBreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_state",
+		Help:      "State of the circuit breaker by name, where 0 is closed, 1 is half open and 2 is open.",
+	}, []string{"name"})
+
+	CircuitBreakerTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_transitions_total",
+		Help:      "Number of circuit breaker state changes by name and the new state.",
+	}, []string{"name", "state"})
+
+	ExchangeRateCacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "exchange_rate_cache_hits_total",
+		Help:      "Number of exchange rate lookups answered from the in-memory cache by exchange rate provider.",
+	}, []string{"provider"})
+
+	ExchangeRateCacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "exchange_rate_cache_misses_total",
+		Help:      "Number of exchange rate lookups not found in the in-memory cache by exchange rate provider.",
+	}, []string{"provider"})
+
+	ExchangeRateLookupsCoalesced = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "exchange_rate_lookups_coalesced_total",
+		Help:      "Number of exchange rate cache misses which shared the result of a concurrent identical lookup by exchange rate provider.",
+	}, []string{"provider"})
+
+	ConversionsOutsideRateWindow = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "conversions_outside_rate_window_total",
+		Help:      "Number of purchase conversions failing as no exchange rate exists within 6 months of the purchase date.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPRequestDuration,
+		TreasuryRequestDuration,
+		TreasuryRetries,
+		TreasuryRateLimited,
+		CircuitBreakerState,
+		CircuitBreakerTransitions,
+		ExchangeRateCacheHits,
+		ExchangeRateCacheMisses,
+		ExchangeRateLookupsCoalesced,
+		ConversionsOutsideRateWindow,
+	)
+}
+
+// Handler serves the collected metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,53 @@
+// Package money represents an amount in a given currency, which is rounded to the minor units the currency has according
+// to ISO 4217 e.g. 2 decimal places for USD, none for JPY and 3 for KWD.
+package money
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// defaultMinorUnits is the number of decimal places of every currency not listed in minorUnits.
+const defaultMinorUnits = 2
+
+// minorUnits holds the ISO 4217 currencies whose number of decimal places differs from defaultMinorUnits.
+var minorUnits = map[string]int32{
+	// currencies without minor units
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	// currencies with thousandths
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	// units of account with ten-thousandths
+	"CLF": 4, "UYW": 4,
+}
+
+// MinorUnits will return the number of decimal places of the ISO 4217 currency code. Unknown codes have 2 decimal places.
+func MinorUnits(currency string) int32 {
+	if units, ok := minorUnits[strings.ToUpper(strings.TrimSpace(currency))]; ok {
+		return units
+	}
+
+	return defaultMinorUnits
+}
+
+// Money is an amount in the currency of the ISO 4217 code.
+type Money struct {
+	Amount   decimal.Decimal
+	Currency string
+}
+
+// New will return the amount in the currency, whose code is normalised to upper case.
+func New(amount decimal.Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(strings.TrimSpace(currency))}
+}
+
+// Round will round the amount to the minor units of its currency with the given rounding mode.
+func (m Money) Round(mode RoundingMode) Money {
+	return Money{Amount: mode.Round(m.Amount, MinorUnits(m.Currency)), Currency: m.Currency}
+}
+
+// String will return the amount followed by the currency code e.g. '10.13 USD'.
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+)
+
+func TestMinorUnits(t *testing.T) {
+	tests := []struct {
+		given string
+		want  int32
+	}{
+		{given: "USD", want: 2},
+		{given: "jpy", want: 0},
+		{given: " KWD ", want: 3},
+		{given: "CLF", want: 4},
+		{given: "XYZ", want: 2},
+		{given: "", want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.given, func(t *testing.T) {
+			assert.Equal(t, tt.want, MinorUnits(tt.given))
+		})
+	}
+}
+
+func TestMoneyRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		mode     RoundingMode
+		want     string
+	}{
+		{name: "should not round for digit less than 5", amount: "12.6544", currency: "USD", want: "12.65"},
+		{name: "should not round for zero", amount: "0", currency: "USD", want: "0"},
+		{name: "should not round amount within minor units", amount: "1.5", currency: "USD", want: "1.5"},
+		{name: "should round for digit greater than five", amount: "12.65766", currency: "USD", want: "12.66"},
+		{name: "should round half up by default", amount: "1.005", currency: "USD", want: "1.01"},
+		{name: "should round half to even", amount: "1.005", currency: "USD", mode: HalfEven, want: "1"},
+		{name: "should round half to even upwards", amount: "1.015", currency: "USD", mode: HalfEven, want: "1.02"},
+		{name: "should round down", amount: "1.009", currency: "USD", mode: Down, want: "1"},
+		{name: "should round to whole yen", amount: "1234.5", currency: "JPY", mode: HalfUp, want: "1235"},
+		{name: "should round to whole yen half to even", amount: "1234.5", currency: "JPY", mode: HalfEven, want: "1234"},
+		{name: "should round to thousandths of dinar", amount: "10.12345", currency: "KWD", mode: HalfUp, want: "10.123"},
+		{name: "should round down to thousandths of dinar", amount: "10.1239", currency: "BHD", mode: Down, want: "10.123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(decimal.RequireFromString(tt.amount), tt.currency).Round(tt.mode)
+
+			assert.Equal(t, tt.want, got.Amount.String())
+			assert.Equal(t, tt.currency, got.Currency)
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	assert.Equal(t, "10.13 EUR", New(decimal.RequireFromString("10.13"), "eur").String())
+}
@@ -0,0 +1,59 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode decides how an amount is rounded to the minor units of its currency.
+type RoundingMode string
+
+const (
+	// HalfUp rounds half away from zero, e.g. 1.005 USD to 1.01 USD
+	HalfUp RoundingMode = "half_up"
+	// HalfEven rounds half to the nearest even digit, also known as banker's rounding, e.g. 1.005 USD to 1.00 USD
+	HalfEven RoundingMode = "half_even"
+	// Down truncates towards zero, e.g. 1.009 USD to 1.00 USD
+	Down RoundingMode = "down"
+)
+
+// DefaultRoundingMode is the rounding mode used when none is configured.
+const DefaultRoundingMode = HalfUp
+
+// RoundingModes are all the supported rounding modes.
+var RoundingModes = []RoundingMode{HalfUp, HalfEven, Down}
+
+// ParseRoundingMode will return the rounding mode of the given name, matched case insensitively.
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	given := RoundingMode(strings.ToLower(strings.TrimSpace(s)))
+	for _, mode := range RoundingModes {
+		if mode == given {
+			return mode, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown rounding mode '%s', supported modes are %v", s, RoundingModes)
+}
+
+// GetMode will return the rounding mode, falling back to the default for the zero value.
+func (r RoundingMode) GetMode() RoundingMode {
+	if r == "" {
+		return DefaultRoundingMode
+	}
+
+	return r
+}
+
+// Round will round the amount to the given number of decimal places.
+func (r RoundingMode) Round(amount decimal.Decimal, places int32) decimal.Decimal {
+	switch r.GetMode() {
+	case HalfEven:
+		return amount.RoundBank(places)
+	case Down:
+		return amount.Truncate(places)
+	default:
+		return amount.Round(places)
+	}
+}
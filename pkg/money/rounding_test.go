@@ -0,0 +1,38 @@
+package money
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestParseRoundingMode(t *testing.T) {
+	tests := []struct {
+		given   string
+		want    RoundingMode
+		wantErr bool
+	}{
+		{given: "half_up", want: HalfUp},
+		{given: " HALF_EVEN ", want: HalfEven},
+		{given: "down", want: Down},
+		{given: "up", wantErr: true},
+		{given: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.given, func(t *testing.T) {
+			got, err := ParseRoundingMode(tt.given)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoundingModeGetMode(t *testing.T) {
+	assert.Equal(t, HalfUp, RoundingMode("").GetMode())
+	assert.Equal(t, Down, Down.GetMode())
+}
@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eddie023/wex-tag/pkg/config"
+	"gotest.tools/assert"
+)
+
+func TestSetup(t *testing.T) {
+	type testcase struct {
+		name string
+		give string
+
+		wantErr string
+	}
+
+	testcases := []testcase{
+		{name: "should not export spans by default", give: ""},
+		{name: "should not export spans with none exporter", give: NoneExporter},
+		{name: "should export spans to stdout", give: StdoutExporter},
+		{name: "should export spans over otlp", give: OTLPExporter},
+		{name: "should fail for unknown exporter", give: "zipkin", wantErr: "unknown tracing exporter 'zipkin'"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.ApiConfig{}
+			cfg.Tracing.Exporter = tc.give
+			cfg.Tracing.ServiceName = "wex-tag"
+			cfg.Tracing.OTLPEndpoint = "localhost:4318"
+			cfg.Tracing.SampleRatio = 1
+
+			shutdown, err := Setup(context.Background(), cfg)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.NilError(t, shutdown(context.Background()))
+		})
+	}
+}
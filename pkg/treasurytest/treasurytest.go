@@ -0,0 +1,404 @@
+// Package treasurytest provides an in-process fake of the treasury rates of exchange API for tests.
+package treasurytest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+//go:embed fixtures
+var fixtures embed.FS
+
+// DefaultFixture is the embedded dataset served by NewServer.
+const DefaultFixture = "fixtures/rates_of_exchange.json"
+
+// defaultPageSize is the page size of the treasury API when page[size] is not given.
+const defaultPageSize = 100
+
+// Record is a single rates of exchange record keyed by the API field names e.g. 'country_currency_desc'.
+type Record map[string]string
+
+// Server is a fake treasury rates of exchange API which supports the filter, fields, sort and page query params used by
+// the service. Failures can be injected to exercise the retries of the client.
+type Server struct {
+	*httptest.Server
+
+	records []Record
+
+	mu          sync.Mutex
+	requests    int
+	queries     []string
+	rateLimited int
+	failures    int
+	failStatus  int
+	retryAfter  string
+	delay       time.Duration
+}
+
+// NewServer will start a fake treasury API serving the embedded fixture. The server is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	f, err := fixtures.Open(DefaultFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	return newServer(t, f)
+}
+
+// NewServerFromFile will start a fake treasury API serving the given fixture, which is a rates of exchange API response
+// body. The server is closed when the test ends.
+func NewServerFromFile(t testing.TB, path string) *Server {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	return newServer(t, f)
+}
+
+func newServer(t testing.TB, r io.Reader) *Server {
+	t.Helper()
+
+	var fixture struct {
+		Data []Record `json:"data"`
+	}
+
+	err := json.NewDecoder(r).Decode(&fixture)
+	if err != nil {
+		t.Fatalf("decoding treasury fixture: %v", err)
+	}
+
+	s := &Server{records: fixture.Data}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Requests returns the number of requests received, including the failed ones.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// Queries returns the raw query of every request received in order.
+func (s *Server) Queries() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.queries...)
+}
+
+// RateLimit will respond to the next n requests with 429 Too Many Requests.
+func (s *Server) RateLimit(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimited = n
+}
+
+// Fail will respond to the next n requests with the given status code, after any rate limited requests.
+func (s *Server) Fail(status int, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failStatus = status
+	s.failures = n
+}
+
+// SetRetryAfter will send the Retry-After header with the rate limited and failed responses.
+func (s *Server) SetRetryAfter(header string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.retryAfter = header
+}
+
+// SetDelay will delay every response by d, or until the request is cancelled.
+func (s *Server) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delay = d
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	status, retryAfter, delay := s.nextResponse(r)
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if status != http.StatusOK {
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	body, err := s.query(r)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "Invalid Query Param", "message": err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// nextResponse records the request and returns the status code and Retry-After header it must be responded with.
+func (s *Server) nextResponse(r *http.Request) (int, string, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	s.queries = append(s.queries, r.URL.RawQuery)
+
+	switch {
+	case s.rateLimited > 0:
+		s.rateLimited--
+		return http.StatusTooManyRequests, s.retryAfter, s.delay
+	case s.failures > 0:
+		s.failures--
+		return s.failStatus, s.retryAfter, s.delay
+	default:
+		return http.StatusOK, "", s.delay
+	}
+}
+
+type response struct {
+	Data  []Record `json:"data"`
+	Meta  meta     `json:"meta"`
+	Links links    `json:"links"`
+}
+
+type meta struct {
+	Count      int `json:"count"`
+	TotalCount int `json:"total-count"`
+	TotalPages int `json:"total-pages"`
+}
+
+type links struct {
+	Self  string  `json:"self"`
+	First string  `json:"first"`
+	Prev  *string `json:"prev"`
+	Next  *string `json:"next"`
+	Last  string  `json:"last"`
+}
+
+// query will apply the filter, sort, page and fields query params to the records the same way as the treasury API.
+func (s *Server) query(r *http.Request) (response, error) {
+	q := r.URL.Query()
+
+	records, err := filterRecords(s.records, q.Get("filter"))
+	if err != nil {
+		return response{}, err
+	}
+
+	sortRecords(records, q.Get("sort"))
+
+	size, err := getIntParam(q.Get("page[size]"), defaultPageSize)
+	if err != nil {
+		return response{}, err
+	}
+
+	number, err := getIntParam(q.Get("page[number]"), 1)
+	if err != nil {
+		return response{}, err
+	}
+
+	totalPages := (len(records) + size - 1) / size
+
+	start := min((number-1)*size, len(records))
+	end := min(start+size, len(records))
+	page := selectFields(records[start:end], q.Get("fields"))
+
+	out := response{
+		Data: page,
+		Meta: meta{Count: len(page), TotalCount: len(records), TotalPages: totalPages},
+		Links: links{
+			Self:  "&page%5Bnumber%5D=" + strconv.Itoa(number) + "&page%5Bsize%5D=" + strconv.Itoa(size),
+			First: "&page%5Bnumber%5D=1&page%5Bsize%5D=" + strconv.Itoa(size),
+			Last:  "&page%5Bnumber%5D=" + strconv.Itoa(max(totalPages, 1)) + "&page%5Bsize%5D=" + strconv.Itoa(size),
+		},
+	}
+
+	if number > 1 {
+		prev := "&page%5Bnumber%5D=" + strconv.Itoa(number-1) + "&page%5Bsize%5D=" + strconv.Itoa(size)
+		out.Links.Prev = &prev
+	}
+
+	if number < totalPages {
+		next := "&page%5Bnumber%5D=" + strconv.Itoa(number+1) + "&page%5Bsize%5D=" + strconv.Itoa(size)
+		out.Links.Next = &next
+	}
+
+	return out, nil
+}
+
+// filterRecords will return the records matching every condition of the filter e.g. 'record_date:lte:2022-09-30,country:eq:Nepal'.
+func filterRecords(records []Record, filter string) ([]Record, error) {
+	type condition struct {
+		field, op, value string
+	}
+
+	var conditions []condition
+
+	if filter != "" {
+		for _, part := range strings.Split(filter, ",") {
+			fields := strings.SplitN(part, ":", 3)
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("invalid filter '%s'", part)
+			}
+
+			switch fields[1] {
+			case "eq", "lt", "lte", "gt", "gte":
+			default:
+				return nil, fmt.Errorf("unsupported filter operator '%s'", fields[1])
+			}
+
+			conditions = append(conditions, condition{field: fields[0], op: fields[1], value: fields[2]})
+		}
+	}
+
+	out := make([]Record, 0, len(records))
+
+outer:
+	for _, record := range records {
+		for _, c := range conditions {
+			cmp := compare(record[c.field], c.value)
+
+			var ok bool
+			switch c.op {
+			case "eq":
+				ok = cmp == 0
+			case "lt":
+				ok = cmp < 0
+			case "lte":
+				ok = cmp <= 0
+			case "gt":
+				ok = cmp > 0
+			case "gte":
+				ok = cmp >= 0
+			}
+
+			if !ok {
+				continue outer
+			}
+		}
+
+		out = append(out, record)
+	}
+
+	return out, nil
+}
+
+// sortRecords will sort the records by the comma separated fields, where fields prefixed with '-' are sorted in
+// descending order.
+func sortRecords(records []Record, by string) {
+	if by == "" {
+		return
+	}
+
+	fields := strings.Split(by, ",")
+
+	sort.SliceStable(records, func(i, j int) bool {
+		for _, field := range fields {
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+
+			cmp := compare(records[i][field], records[j][field])
+			if cmp == 0 {
+				continue
+			}
+
+			if desc {
+				return cmp > 0
+			}
+
+			return cmp < 0
+		}
+
+		return false
+	})
+}
+
+// selectFields will only keep the comma separated fields of every record, or all of them when fields is empty.
+func selectFields(records []Record, fields string) []Record {
+	if fields == "" {
+		return records
+	}
+
+	out := make([]Record, 0, len(records))
+
+	for _, record := range records {
+		selected := make(Record)
+		for _, field := range strings.Split(fields, ",") {
+			selected[field] = record[field]
+		}
+
+		out = append(out, selected)
+	}
+
+	return out
+}
+
+// compare will compare the values as numbers when both of them are numeric, otherwise as strings. Dates in the
+// YYYY-MM-DD format compare correctly as strings.
+func compare(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+func getIntParam(value string, def int) (int, error) {
+	if value == "" {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid page param '%s'", value)
+	}
+
+	return n, nil
+}
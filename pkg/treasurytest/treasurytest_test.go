@@ -0,0 +1,96 @@
+package treasurytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestServer(t *testing.T) {
+	type testcase struct {
+		name     string
+		rawQuery string
+
+		wantStatus int
+		wantRates  []string
+		wantNext   bool
+	}
+
+	testcases := []testcase{
+		{
+			name:       "should return latest rate on or before the record date",
+			rawQuery:   "filter=record_date:lte:2022-11-30,country_currency_desc:eq:Nepal-Rupee&fields=country_currency_desc,exchange_rate,record_date&sort=-record_date&page[size]=1",
+			wantStatus: http.StatusOK,
+			wantRates:  []string{"130.5"},
+			wantNext:   true,
+		},
+		{
+			name:       "should decode escaped filter values",
+			rawQuery:   "filter=country_currency_desc:eq:United+Kingdom-Pound&sort=record_date",
+			wantStatus: http.StatusOK,
+			wantRates:  []string{"0.896", "0.831"},
+		},
+		{
+			name:       "should return the requested page",
+			rawQuery:   "filter=country:eq:Nepal&sort=record_date&page%5Bnumber%5D=3&page%5Bsize%5D=1",
+			wantStatus: http.StatusOK,
+			wantRates:  []string{"132.5"},
+		},
+		{
+			name:       "should fail for unsupported filter operator",
+			rawQuery:   "filter=country:in:(Nepal)",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	s := NewServer(t)
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Get(s.URL + "?" + tc.rawQuery)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			var body response
+			err = json.NewDecoder(resp.Body).Decode(&body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rates := make([]string, 0, len(body.Data))
+			for _, record := range body.Data {
+				rates = append(rates, record["exchange_rate"])
+			}
+
+			assert.DeepEqual(t, tc.wantRates, rates)
+			assert.Equal(t, tc.wantNext, body.Links.Next != nil)
+		})
+	}
+}
+
+func TestServerFailures(t *testing.T) {
+	s := NewServer(t)
+	s.RateLimit(1)
+	s.Fail(http.StatusServiceUnavailable, 1)
+
+	for _, want := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK} {
+		resp, err := http.Get(s.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		assert.Equal(t, want, resp.StatusCode)
+	}
+
+	assert.Equal(t, 3, s.Requests())
+}
@@ -21,15 +21,235 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ProblemCode.
+const (
+	ProblemCodeBadRequest                     ProblemCode = "bad_request"
+	ProblemCodeExchangeRateNotFound           ProblemCode = "exchange_rate_not_found"
+	ProblemCodeExchangeRateServiceUnavailable ProblemCode = "exchange_rate_service_unavailable"
+	ProblemCodeExchangeRateUnavailable        ProblemCode = "exchange_rate_unavailable"
+	ProblemCodeIdempotencyKeyInProgress       ProblemCode = "idempotency_key_in_progress"
+	ProblemCodeIdempotencyKeyReused           ProblemCode = "idempotency_key_reused"
+	ProblemCodeInternalError                  ProblemCode = "internal_error"
+	ProblemCodeInvalidAmount                  ProblemCode = "invalid_amount"
+	ProblemCodeInvalidConversionTarget        ProblemCode = "invalid_conversion_target"
+	ProblemCodeInvalidCurrency                ProblemCode = "invalid_currency"
+	ProblemCodeInvalidCursor                  ProblemCode = "invalid_cursor"
+	ProblemCodeInvalidQueryParameter          ProblemCode = "invalid_query_parameter"
+	ProblemCodeInvalidRequestBody             ProblemCode = "invalid_request_body"
+	ProblemCodeInvalidTransactionDate         ProblemCode = "invalid_transaction_date"
+	ProblemCodeInvalidTransactionId           ProblemCode = "invalid_transaction_id"
+	ProblemCodeMethodNotAllowed               ProblemCode = "method_not_allowed"
+	ProblemCodeNotAcceptable                  ProblemCode = "not_acceptable"
+	ProblemCodeNotFound                       ProblemCode = "not_found"
+	ProblemCodeRequestBodyTooLarge            ProblemCode = "request_body_too_large"
+	ProblemCodeTransactionNotFound            ProblemCode = "transaction_not_found"
+	ProblemCodeUnsupportedMediaType           ProblemCode = "unsupported_media_type"
+	ProblemCodeValidationFailed               ProblemCode = "validation_failed"
+)
+
+// Defines values for RatePolicy.
+const (
+	RatePolicyEarliestAfter    RatePolicy = "earliest_after"
+	RatePolicyLatestOnOrBefore RatePolicy = "latest_on_or_before"
+	RatePolicyNearest          RatePolicy = "nearest"
+	RatePolicySameQuarter      RatePolicy = "same_quarter"
+)
+
+// Defines values for RoundingMode.
+const (
+	RoundingModeDown     RoundingMode = "down"
+	RoundingModeHalfEven RoundingMode = "half_even"
+	RoundingModeHalfUp   RoundingMode = "half_up"
+)
+
+// Defines values for ViolationIn.
+const (
+	ViolationInBody   ViolationIn = "body"
+	ViolationInCookie ViolationIn = "cookie"
+	ViolationInHeader ViolationIn = "header"
+	ViolationInPath   ViolationIn = "path"
+	ViolationInQuery  ViolationIn = "query"
+)
+
+// ConversionTarget defines model for ConversionTarget.
+type ConversionTarget struct {
+	// Country country for which purchase amount should be retrived
+	Country string `json:"country"`
+
+	// Currency currency for which purchase transaction should be converted to
+	Currency string `json:"currency"`
+}
+
 // ConvertedPurchasePrice defines model for ConvertedPurchasePrice.
 type ConvertedPurchasePrice struct {
-	Amount           string `json:"amount"`
-	Country          string `json:"country"`
-	Currency         string `json:"currency"`
-	ExchangeRateDate string `json:"exchangeRateDate"`
-	ExchangeRateUsed string `json:"exchangeRateUsed"`
+	Amount  string `json:"amount"`
+	Country string `json:"country"`
+
+	// CountryCode ISO 3166-1 alpha-2 code of the country when known
+	CountryCode *string `json:"countryCode,omitempty"`
+
+	// CountryCurrencyDesc treasury descriptor of the country and currency e.g. United Kingdom-Pound
+	CountryCurrencyDesc *string `json:"countryCurrencyDesc,omitempty"`
+	Currency            string  `json:"currency"`
+
+	// CurrencyCode ISO 4217 code of the currency when known
+	CurrencyCode     *string `json:"currencyCode,omitempty"`
+	ExchangeRateDate string  `json:"exchangeRateDate"`
+	ExchangeRateUsed string  `json:"exchangeRateUsed"`
+
+	// Provider name of the exchange rate provider which served the exchange rate. e.g. treasury, ecb or file
+	Provider *string `json:"provider,omitempty"`
+
+	// RatePolicy policy selecting the exchange rate of a purchase among the rates recorded around the purchase date. The lookback window of
+	// latest_on_or_before, nearest and earliest_after is configured on the server and defaults to 6 months.
+	//   * latest_on_or_before - latest rate recorded on or before the purchase date within the lookback window
+	//   * nearest - rate recorded nearest to the purchase date on either side within the lookback window, the earlier rate on a tie
+	//   * same_quarter - latest rate recorded within the calendar quarter of the purchase date
+	//   * earliest_after - earliest rate recorded on or after the purchase date within the lookback window
+	RatePolicy *RatePolicy `json:"ratePolicy,omitempty"`
+
+	// RoundingMode rounding of an amount to the ISO 4217 minor units of its currency e.g. 2 decimal places for USD, none for JPY and 3 for KWD.
+	// The mode is configured on the server and defaults to half_up.
+	//   * half_up - half away from zero
+	//   * half_even - half to the nearest even digit, also known as banker's rounding
+	//   * down - truncated towards zero
+	RoundingMode *RoundingMode `json:"roundingMode,omitempty"`
+
+	// SourceAmount original amount of a purchase made in a currency other than USD, which is converted through USD instead of amountInUSD.
+	// The amount is sourceAmount * exchangeRateUsed / sourceExchangeRateUsed, computed without rounding the intermediate USD amount
+	// and rounded to the minor units of the currency with the roundingMode only once at the end.
+	SourceAmount *string `json:"sourceAmount,omitempty"`
+
+	// SourceCurrency ISO 4217 code of the currency the purchase was made in
+	SourceCurrency *string `json:"sourceCurrency,omitempty"`
+
+	// SourceExchangeRateDate record date of the source exchange rate, always equal to exchangeRateDate
+	SourceExchangeRateDate *string `json:"sourceExchangeRateDate,omitempty"`
+
+	// SourceExchangeRateUsed exchange rate of the source currency to USD, recorded on the same date as exchangeRateUsed
+	SourceExchangeRateUsed *string `json:"sourceExchangeRateUsed,omitempty"`
+}
+
+// CurrencyConversionResult defines model for CurrencyConversionResult.
+type CurrencyConversionResult struct {
+	ConvertedDetails *ConvertedPurchasePrice `json:"convertedDetails,omitempty"`
+	Country          string                  `json:"country"`
+	Currency         string                  `json:"currency"`
+
+	// Error reason why the purchase could not be converted to the target currency
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode machine readable error code of the error. See Problem code
+	ErrorCode *string `json:"errorCode,omitempty"`
+
+	// Status HTTP status code of the conversion. 200 when the purchase was converted
+	Status int `json:"status"`
+}
+
+// ExchangeRatePoint defines model for ExchangeRatePoint.
+type ExchangeRatePoint struct {
+	// Eligible whether the rate can be used to convert a purchase made on purchaseDate. Only returned when purchaseDate is provided
+	Eligible *bool `json:"eligible,omitempty"`
+
+	// ExchangeRate amount of currency for 1 USD
+	ExchangeRate string `json:"exchangeRate"`
+	RecordDate   string `json:"recordDate"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	// Name name of the query or path parameter, or the JSON pointer of the request body field
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Problem RFC 7807 problem details returned for every failed request
+type Problem struct {
+	// Code stable machine readable error code. Clients should match on the code instead of the detail
+	Code ProblemCode `json:"code"`
+
+	// Detail human readable explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors violations of the OpenAPI specification found by the request validator, reported for validation_failed
+	Errors *[]Violation `json:"errors,omitempty"`
+
+	// Instance path of the request which caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// InvalidParams request fields which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+
+	// Status HTTP status code of the response
+	Status int `json:"status"`
+
+	// Title short human readable summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI reference identifying the problem type. e.g. urn:wex-tag:problem:transaction_not_found
+	Type string `json:"type"`
+}
+
+// ProblemCode stable machine readable error code. Clients should match on the code instead of the detail
+type ProblemCode string
+
+// PurchaseTransactionListItem defines model for PurchaseTransactionListItem.
+type PurchaseTransactionListItem struct {
+	// ConversionError reason why the transaction could not be converted to the requested currency
+	ConversionError *string `json:"conversionError,omitempty"`
+
+	// ConversionErrorCode machine readable error code of the conversion error. See Problem code
+	ConversionErrorCode *string                 `json:"conversionErrorCode,omitempty"`
+	ConvertedDetails    *ConvertedPurchasePrice `json:"convertedDetails,omitempty"`
+	TransactionDetails  Transaction             `json:"transactionDetails"`
+}
+
+// RatePolicy policy selecting the exchange rate of a purchase among the rates recorded around the purchase date. The lookback window of
+// latest_on_or_before, nearest and earliest_after is configured on the server and defaults to 6 months.
+//   - latest_on_or_before - latest rate recorded on or before the purchase date within the lookback window
+//   - nearest - rate recorded nearest to the purchase date on either side within the lookback window, the earlier rate on a tie
+//   - same_quarter - latest rate recorded within the calendar quarter of the purchase date
+//   - earliest_after - earliest rate recorded on or after the purchase date within the lookback window
+type RatePolicy string
+
+// RoundingMode rounding of an amount to the ISO 4217 minor units of its currency e.g. 2 decimal places for USD, none for JPY and 3 for KWD.
+// The mode is configured on the server and defaults to half_up.
+//   - half_up - half away from zero
+//   - half_even - half to the nearest even digit, also known as banker's rounding
+//   - down - truncated towards zero
+type RoundingMode string
+
+// SupportedCurrency defines model for SupportedCurrency.
+type SupportedCurrency struct {
+	// Country treasury country to pass as the country query param
+	Country string `json:"country"`
+
+	// CountryCode ISO 3166-1 alpha-2 code of the country, when known
+	CountryCode *string `json:"countryCode,omitempty"`
+
+	// CountryCurrencyDesc country and currency joined by '-' as returned by the treasury API e.g. 'United Kingdom-Pound'
+	CountryCurrencyDesc string `json:"countryCurrencyDesc"`
+
+	// Currency treasury currency to pass as the currency query param
+	Currency string `json:"currency"`
+
+	// CurrencyCode ISO 4217 code of the currency, when known
+	CurrencyCode *string `json:"currencyCode,omitempty"`
+
+	// FirstRecordDate date of the oldest available rate
+	FirstRecordDate string `json:"firstRecordDate"`
+
+	// LastRecordDate date of the latest available rate
+	LastRecordDate string `json:"lastRecordDate"`
+
+	// LatestRate latest available rate as the amount of currency for 1 USD
+	LatestRate string `json:"latestRate"`
+
+	// Provider name of the exchange rate provider the pair is served by
+	Provider string `json:"provider"`
 }
 
 // Transaction defines model for Transaction.
@@ -38,41 +258,277 @@ type Transaction struct {
 	Date        time.Time `json:"date"`
 	Description string    `json:"description"`
 	Id          string    `json:"id"`
+
+	// OriginalAmount amount of the purchase in the currency it was made in. Only set for purchases made in a currency other than USD.
+	OriginalAmount *string `json:"originalAmount,omitempty"`
+
+	// OriginalCurrency ISO 4217 code of the currency the purchase was made in e.g. 'EUR'.
+	OriginalCurrency *string `json:"originalCurrency,omitempty"`
+
+	// OriginalExchangeRate treasury exchange rate of the original currency to USD which amountInUSD was derived with.
+	OriginalExchangeRate *string `json:"originalExchangeRate,omitempty"`
+
+	// OriginalExchangeRateDate record date of the exchange rate used to derive amountInUSD.
+	OriginalExchangeRateDate *string `json:"originalExchangeRateDate,omitempty"`
+}
+
+// TransactionConversionResult defines model for TransactionConversionResult.
+type TransactionConversionResult struct {
+	ConvertedDetails *ConvertedPurchasePrice `json:"convertedDetails,omitempty"`
+
+	// Error reason why the transaction could not be converted to the target currency
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode machine readable error code of the error. See Problem code
+	ErrorCode *string `json:"errorCode,omitempty"`
+
+	// Status HTTP status code of the conversion. 200 when the purchase was converted
+	Status             int          `json:"status"`
+	TransactionDetails *Transaction `json:"transactionDetails,omitempty"`
+	TransactionId      string       `json:"transactionId"`
+}
+
+// Violation defines model for Violation.
+type Violation struct {
+	// In location of the parameter, or body for request body fields
+	In *ViolationIn `json:"in,omitempty"`
+
+	// Name name of the parameter, or the JSON pointer of the request body field
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ViolationIn location of the parameter, or body for request body fields
+type ViolationIn string
+
+// ConvertedPurchaseTransaction defines model for ConvertedPurchaseTransaction.
+type ConvertedPurchaseTransaction struct {
+	Conversions        []CurrencyConversionResult `json:"conversions"`
+	TransactionDetails Transaction                `json:"transactionDetails"`
+}
+
+// ConvertedPurchaseTransactions defines model for ConvertedPurchaseTransactions.
+type ConvertedPurchaseTransactions struct {
+	Results []TransactionConversionResult `json:"results"`
 }
 
 // CreatePurchaseTransaction defines model for CreatePurchaseTransaction.
 type CreatePurchaseTransaction = Transaction
 
+// ExchangeRateHistory defines model for ExchangeRateHistory.
+type ExchangeRateHistory struct {
+	Country             string `json:"country"`
+	CountryCurrencyDesc string `json:"countryCurrencyDesc"`
+	Currency            string `json:"currency"`
+
+	// EligibleFrom oldest record date eligible for purchaseDate. Only returned when purchaseDate is provided
+	EligibleFrom *string `json:"eligibleFrom,omitempty"`
+
+	// EligibleTo latest record date eligible for purchaseDate. Only returned when purchaseDate is provided
+	EligibleTo *string `json:"eligibleTo,omitempty"`
+	From       string  `json:"from"`
+
+	// Provider name of the exchange rate provider which served the rates. Not returned when there is no rate in the range
+	Provider     *string `json:"provider,omitempty"`
+	PurchaseDate *string `json:"purchaseDate,omitempty"`
+
+	// RatePolicy policy selecting the exchange rate of a purchase among the rates recorded around the purchase date. The lookback window of
+	// latest_on_or_before, nearest and earliest_after is configured on the server and defaults to 6 months.
+	//   * latest_on_or_before - latest rate recorded on or before the purchase date within the lookback window
+	//   * nearest - rate recorded nearest to the purchase date on either side within the lookback window, the earlier rate on a tie
+	//   * same_quarter - latest rate recorded within the calendar quarter of the purchase date
+	//   * earliest_after - earliest rate recorded on or after the purchase date within the lookback window
+	RatePolicy *RatePolicy         `json:"ratePolicy,omitempty"`
+	Rates      []ExchangeRatePoint `json:"rates"`
+	To         string              `json:"to"`
+}
+
 // GetPurchaseTransaction defines model for GetPurchaseTransaction.
 type GetPurchaseTransaction struct {
 	ConvertedDetails   ConvertedPurchasePrice `json:"convertedDetails"`
 	TransactionDetails Transaction            `json:"transactionDetails"`
 }
 
+// ListCurrencies defines model for ListCurrencies.
+type ListCurrencies struct {
+	Items []SupportedCurrency `json:"items"`
+}
+
+// ListPurchaseTransactions defines model for ListPurchaseTransactions.
+type ListPurchaseTransactions struct {
+	Items []PurchaseTransactionListItem `json:"items"`
+
+	// NextCursor cursor to fetch the next page. Not returned on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ConvertPurchaseTransaction defines model for ConvertPurchaseTransaction.
+type ConvertPurchaseTransaction struct {
+	Targets []ConversionTarget `json:"targets"`
+}
+
+// ConvertPurchaseTransactions defines model for ConvertPurchaseTransactions.
+type ConvertPurchaseTransactions struct {
+	// Country country for which purchase amounts should be retrived
+	Country string `json:"country"`
+
+	// Currency currency to which purchase amounts should be converted
+	Currency       string   `json:"currency"`
+	TransactionIds []string `json:"transactionIds"`
+}
+
 // CreateNewPurchaseTransaction defines model for CreateNewPurchaseTransaction.
 type CreateNewPurchaseTransaction struct {
-	Amount      string `json:"amount"`
-	Description string `json:"description"`
+	// Amount amount of the purchase in USD. Required unless originalAmount and originalCurrency are provided.
+	Amount      *string `json:"amount,omitempty"`
+	Description string  `json:"description"`
+
+	// OriginalAmount amount of the purchase in the currency it was made in. Converted to USD with the treasury exchange rate of the purchase date.
+	OriginalAmount *string `json:"originalAmount,omitempty"`
+
+	// OriginalCurrency ISO 4217 code of the currency the purchase was made in e.g. 'EUR'. Required along with originalAmount.
+	OriginalCurrency *string `json:"originalCurrency,omitempty"`
+
+	// TransactionDate date on which the purchase was made. Defaults to the time of the request and cannot be in the future.
+	TransactionDate *time.Time `json:"transactionDate,omitempty"`
+}
+
+// ListCurrenciesParams defines parameters for ListCurrencies.
+type ListCurrenciesParams struct {
+	// Country only return the pairs whose country starts with the given prefix, matched case insensitively
+	Country *string `form:"country,omitempty" json:"country,omitempty"`
+}
+
+// ListExchangeRatesParams defines parameters for ListExchangeRates.
+type ListExchangeRatesParams struct {
+	// Country treasury country of the exchange rates
+	Country string `form:"country" json:"country"`
+
+	// Currency treasury currency of the exchange rates
+	Currency string `form:"currency" json:"currency"`
+
+	// From only return rates recorded on or after this date. Defaults to 1 year before 'to'
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To only return rates recorded on or before this date. Defaults to today
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// PurchaseDate purchase date for which the eligibility of every rate is reported
+	PurchaseDate *openapi_types.Date `form:"purchaseDate,omitempty" json:"purchaseDate,omitempty"`
+
+	// RatePolicy policy by which the eligibility of every rate is reported. Defaults to the policy configured on the server
+	RatePolicy *RatePolicy `form:"ratePolicy,omitempty" json:"ratePolicy,omitempty"`
+}
+
+// ListPurchaseTransactionsParams defines parameters for ListPurchaseTransactions.
+type ListPurchaseTransactionsParams struct {
+	// Cursor cursor returned by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit maximum number of transactions to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// FromDate only return transactions made on or after this date
+	FromDate *time.Time `form:"fromDate,omitempty" json:"fromDate,omitempty"`
+
+	// ToDate only return transactions made on or before this date
+	ToDate *time.Time `form:"toDate,omitempty" json:"toDate,omitempty"`
+
+	// MinAmount only return transactions with amount in USD greater than or equal to this amount
+	MinAmount *string `form:"minAmount,omitempty" json:"minAmount,omitempty"`
+
+	// MaxAmount only return transactions with amount in USD less than or equal to this amount
+	MaxAmount *string `form:"maxAmount,omitempty" json:"maxAmount,omitempty"`
+
+	// Description only return transactions whose description contains this text (case insensitive)
+	Description *string `form:"description,omitempty" json:"description,omitempty"`
+
+	// Country country for which purchase amounts should be converted. Must be provided along with currency
+	Country *string `form:"country,omitempty" json:"country,omitempty"`
+
+	// Currency currency to which purchase amounts should be converted. Must be provided along with country
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// RatePolicy policy selecting the exchange rate of every converted purchase. Defaults to the policy configured on the server
+	RatePolicy *RatePolicy `form:"ratePolicy,omitempty" json:"ratePolicy,omitempty"`
 }
 
 // PostPurchaseTransactionJSONBody defines parameters for PostPurchaseTransaction.
 type PostPurchaseTransactionJSONBody struct {
-	Amount      string `json:"amount"`
-	Description string `json:"description"`
+	// Amount amount of the purchase in USD. Required unless originalAmount and originalCurrency are provided.
+	Amount      *string `json:"amount,omitempty"`
+	Description string  `json:"description"`
+
+	// OriginalAmount amount of the purchase in the currency it was made in. Converted to USD with the treasury exchange rate of the purchase date.
+	OriginalAmount *string `json:"originalAmount,omitempty"`
+
+	// OriginalCurrency ISO 4217 code of the currency the purchase was made in e.g. 'EUR'. Required along with originalAmount.
+	OriginalCurrency *string `json:"originalCurrency,omitempty"`
+
+	// TransactionDate date on which the purchase was made. Defaults to the time of the request and cannot be in the future.
+	TransactionDate *time.Time `json:"transactionDate,omitempty"`
+}
+
+// PostPurchaseTransactionParams defines parameters for PostPurchaseTransaction.
+type PostPurchaseTransactionParams struct {
+	// IdempotencyKey unique key generated by the client to safely retry the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// ConvertPurchaseTransactionsJSONBody defines parameters for ConvertPurchaseTransactions.
+type ConvertPurchaseTransactionsJSONBody struct {
+	// Country country for which purchase amounts should be retrived
+	Country string `json:"country"`
+
+	// Currency currency to which purchase amounts should be converted
+	Currency       string   `json:"currency"`
+	TransactionIds []string `json:"transactionIds"`
+}
+
+// ConvertPurchaseTransactionsParams defines parameters for ConvertPurchaseTransactions.
+type ConvertPurchaseTransactionsParams struct {
+	// RatePolicy policy selecting the exchange rate of every purchase. Defaults to the policy configured on the server
+	RatePolicy *RatePolicy `form:"ratePolicy,omitempty" json:"ratePolicy,omitempty"`
 }
 
 // GetPurchaseTransactionParams defines parameters for GetPurchaseTransaction.
 type GetPurchaseTransactionParams struct {
-	// Country country for which purchase amount should be retrived
-	Country string `form:"country" json:"country"`
+	// Country treasury country for which purchase amount should be retrived. Must be provided along with currency unless the ISO codes are used
+	Country *string `form:"country,omitempty" json:"country,omitempty"`
 
-	// Currency currency for which purchase transaction should be converted to
-	Currency string `form:"currency" json:"currency"`
+	// Currency treasury currency for which purchase transaction should be converted to. Must be provided along with country unless the ISO codes are used
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// CountryCode ISO 3166-1 alpha-2 code of the country e.g. GB, or EU for the euro zone. Alone it selects the default currency of the country, along with currencyCode it selects the country of a shared currency
+	CountryCode *string `form:"countryCode,omitempty" json:"countryCode,omitempty"`
+
+	// CurrencyCode ISO 4217 code of the currency e.g. GBP. A currency used by several countries such as EUR resolves to a default country unless countryCode is given
+	CurrencyCode *string `form:"currencyCode,omitempty" json:"currencyCode,omitempty"`
+
+	// RatePolicy policy selecting the exchange rate among the rates recorded around the purchase date. Defaults to the policy configured on the server
+	RatePolicy *RatePolicy `form:"ratePolicy,omitempty" json:"ratePolicy,omitempty"`
+}
+
+// ConvertPurchaseTransactionJSONBody defines parameters for ConvertPurchaseTransaction.
+type ConvertPurchaseTransactionJSONBody struct {
+	Targets []ConversionTarget `json:"targets"`
+}
+
+// ConvertPurchaseTransactionParams defines parameters for ConvertPurchaseTransaction.
+type ConvertPurchaseTransactionParams struct {
+	// RatePolicy policy selecting the exchange rate of every conversion. Defaults to the policy configured on the server
+	RatePolicy *RatePolicy `form:"ratePolicy,omitempty" json:"ratePolicy,omitempty"`
 }
 
 // PostPurchaseTransactionJSONRequestBody defines body for PostPurchaseTransaction for application/json ContentType.
 type PostPurchaseTransactionJSONRequestBody PostPurchaseTransactionJSONBody
 
+// ConvertPurchaseTransactionsJSONRequestBody defines body for ConvertPurchaseTransactions for application/json ContentType.
+type ConvertPurchaseTransactionsJSONRequestBody ConvertPurchaseTransactionsJSONBody
+
+// ConvertPurchaseTransactionJSONRequestBody defines body for ConvertPurchaseTransaction for application/json ContentType.
+type ConvertPurchaseTransactionJSONRequestBody ConvertPurchaseTransactionJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -146,17 +602,96 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListCurrencies request
+	ListCurrencies(ctx context.Context, params *ListCurrenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExchangeRates request
+	ListExchangeRates(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPurchaseTransactions request
+	ListPurchaseTransactions(ctx context.Context, params *ListPurchaseTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPurchaseTransactionWithBody request with any body
-	PostPurchaseTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostPurchaseTransactionWithBody(ctx context.Context, params *PostPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPurchaseTransaction(ctx context.Context, params *PostPurchaseTransactionParams, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConvertPurchaseTransactionsWithBody request with any body
+	ConvertPurchaseTransactionsWithBody(ctx context.Context, params *ConvertPurchaseTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostPurchaseTransaction(ctx context.Context, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ConvertPurchaseTransactions(ctx context.Context, params *ConvertPurchaseTransactionsParams, body ConvertPurchaseTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetPurchaseTransaction request
 	GetPurchaseTransaction(ctx context.Context, transactionId string, params *GetPurchaseTransactionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConvertPurchaseTransactionWithBody request with any body
+	ConvertPurchaseTransactionWithBody(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConvertPurchaseTransaction(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, body ConvertPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListCurrencies(ctx context.Context, params *ListCurrenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCurrenciesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListExchangeRates(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExchangeRatesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPurchaseTransactions(ctx context.Context, params *ListPurchaseTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPurchaseTransactionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPurchaseTransactionWithBody(ctx context.Context, params *PostPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPurchaseTransactionRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPurchaseTransaction(ctx context.Context, params *PostPurchaseTransactionParams, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPurchaseTransactionRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) PostPurchaseTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPurchaseTransactionRequestWithBody(c.Server, contentType, body)
+func (c *Client) ConvertPurchaseTransactionsWithBody(ctx context.Context, params *ConvertPurchaseTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertPurchaseTransactionsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -167,8 +702,8 @@ func (c *Client) PostPurchaseTransactionWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPurchaseTransaction(ctx context.Context, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPurchaseTransactionRequest(c.Server, body)
+func (c *Client) ConvertPurchaseTransactions(ctx context.Context, params *ConvertPurchaseTransactionsParams, body ConvertPurchaseTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertPurchaseTransactionsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -191,19 +726,32 @@ func (c *Client) GetPurchaseTransaction(ctx context.Context, transactionId strin
 	return c.Client.Do(req)
 }
 
-// NewPostPurchaseTransactionRequest calls the generic PostPurchaseTransaction builder with application/json body
-func NewPostPurchaseTransactionRequest(server string, body PostPurchaseTransactionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ConvertPurchaseTransactionWithBody(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertPurchaseTransactionRequestWithBody(c.Server, transactionId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPurchaseTransactionRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPurchaseTransactionRequestWithBody generates requests for PostPurchaseTransaction with any type of body
-func NewPostPurchaseTransactionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func (c *Client) ConvertPurchaseTransaction(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, body ConvertPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertPurchaseTransactionRequest(c.Server, transactionId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListCurrenciesRequest generates requests for ListCurrencies
+func NewListCurrenciesRequest(server string, params *ListCurrenciesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -211,7 +759,7 @@ func NewPostPurchaseTransactionRequestWithBody(server string, contentType string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/purchase")
+	operationPath := fmt.Sprintf("/currencies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -221,33 +769,46 @@ func NewPostPurchaseTransactionRequestWithBody(server string, contentType string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Country != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "country", runtime.ParamLocationQuery, *params.Country); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetPurchaseTransactionRequest generates requests for GetPurchaseTransaction
-func NewGetPurchaseTransactionRequest(server string, transactionId string, params *GetPurchaseTransactionParams) (*http.Request, error) {
+// NewListExchangeRatesRequest generates requests for ListExchangeRates
+func NewListExchangeRatesRequest(server string, params *ListExchangeRatesParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "transactionId", runtime.ParamLocationPath, transactionId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/purchase/%s", pathParam0)
+	operationPath := fmt.Sprintf("/exchange-rates")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -284,240 +845,1641 @@ func NewGetPurchaseTransactionRequest(server string, transactionId string, param
 			}
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	return req, nil
-}
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+		}
+
+		if params.PurchaseDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purchaseDate", runtime.ParamLocationQuery, *params.PurchaseDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RatePolicy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ratePolicy", runtime.ParamLocationQuery, *params.RatePolicy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPurchaseTransactionsRequest generates requests for ListPurchaseTransactions
+func NewListPurchaseTransactionsRequest(server string, params *ListPurchaseTransactionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FromDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fromDate", runtime.ParamLocationQuery, *params.FromDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ToDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "toDate", runtime.ParamLocationQuery, *params.ToDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinAmount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minAmount", runtime.ParamLocationQuery, *params.MinAmount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxAmount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxAmount", runtime.ParamLocationQuery, *params.MaxAmount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Description != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Country != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "country", runtime.ParamLocationQuery, *params.Country); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RatePolicy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ratePolicy", runtime.ParamLocationQuery, *params.RatePolicy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPurchaseTransactionRequest calls the generic PostPurchaseTransaction builder with application/json body
+func NewPostPurchaseTransactionRequest(server string, params *PostPurchaseTransactionParams, body PostPurchaseTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPurchaseTransactionRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostPurchaseTransactionRequestWithBody generates requests for PostPurchaseTransaction with any type of body
+func NewPostPurchaseTransactionRequestWithBody(server string, params *PostPurchaseTransactionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewConvertPurchaseTransactionsRequest calls the generic ConvertPurchaseTransactions builder with application/json body
+func NewConvertPurchaseTransactionsRequest(server string, params *ConvertPurchaseTransactionsParams, body ConvertPurchaseTransactionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConvertPurchaseTransactionsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewConvertPurchaseTransactionsRequestWithBody generates requests for ConvertPurchaseTransactions with any type of body
+func NewConvertPurchaseTransactionsRequestWithBody(server string, params *ConvertPurchaseTransactionsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase/convert")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RatePolicy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ratePolicy", runtime.ParamLocationQuery, *params.RatePolicy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPurchaseTransactionRequest generates requests for GetPurchaseTransaction
+func NewGetPurchaseTransactionRequest(server string, transactionId string, params *GetPurchaseTransactionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "transactionId", runtime.ParamLocationPath, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Country != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "country", runtime.ParamLocationQuery, *params.Country); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CountryCode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "countryCode", runtime.ParamLocationQuery, *params.CountryCode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CurrencyCode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currencyCode", runtime.ParamLocationQuery, *params.CurrencyCode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RatePolicy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ratePolicy", runtime.ParamLocationQuery, *params.RatePolicy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConvertPurchaseTransactionRequest calls the generic ConvertPurchaseTransaction builder with application/json body
+func NewConvertPurchaseTransactionRequest(server string, transactionId string, params *ConvertPurchaseTransactionParams, body ConvertPurchaseTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConvertPurchaseTransactionRequestWithBody(server, transactionId, params, "application/json", bodyReader)
+}
+
+// NewConvertPurchaseTransactionRequestWithBody generates requests for ConvertPurchaseTransaction with any type of body
+func NewConvertPurchaseTransactionRequestWithBody(server string, transactionId string, params *ConvertPurchaseTransactionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "transactionId", runtime.ParamLocationPath, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase/%s/conversions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RatePolicy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ratePolicy", runtime.ParamLocationQuery, *params.RatePolicy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
 			return err
 		}
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListCurrenciesWithResponse request
+	ListCurrenciesWithResponse(ctx context.Context, params *ListCurrenciesParams, reqEditors ...RequestEditorFn) (*ListCurrenciesResponse, error)
+
+	// ListExchangeRatesWithResponse request
+	ListExchangeRatesWithResponse(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*ListExchangeRatesResponse, error)
+
+	// ListPurchaseTransactionsWithResponse request
+	ListPurchaseTransactionsWithResponse(ctx context.Context, params *ListPurchaseTransactionsParams, reqEditors ...RequestEditorFn) (*ListPurchaseTransactionsResponse, error)
+
+	// PostPurchaseTransactionWithBodyWithResponse request with any body
+	PostPurchaseTransactionWithBodyWithResponse(ctx context.Context, params *PostPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error)
+
+	PostPurchaseTransactionWithResponse(ctx context.Context, params *PostPurchaseTransactionParams, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error)
+
+	// ConvertPurchaseTransactionsWithBodyWithResponse request with any body
+	ConvertPurchaseTransactionsWithBodyWithResponse(ctx context.Context, params *ConvertPurchaseTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionsResponse, error)
+
+	ConvertPurchaseTransactionsWithResponse(ctx context.Context, params *ConvertPurchaseTransactionsParams, body ConvertPurchaseTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionsResponse, error)
+
+	// GetPurchaseTransactionWithResponse request
+	GetPurchaseTransactionWithResponse(ctx context.Context, transactionId string, params *GetPurchaseTransactionParams, reqEditors ...RequestEditorFn) (*GetPurchaseTransactionResponse, error)
+
+	// ConvertPurchaseTransactionWithBodyWithResponse request with any body
+	ConvertPurchaseTransactionWithBodyWithResponse(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionResponse, error)
+
+	ConvertPurchaseTransactionWithResponse(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, body ConvertPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionResponse, error)
+}
+
+type ListCurrenciesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListCurrencies
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCurrenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCurrenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListExchangeRatesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ExchangeRateHistory
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON503     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExchangeRatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExchangeRatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPurchaseTransactionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListPurchaseTransactions
+	XML200                        *ListPurchaseTransactions
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON406     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPurchaseTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPurchaseTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPurchaseTransactionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CreatePurchaseTransaction
+	XML201                        *CreatePurchaseTransaction
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON406     *Problem
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSON422     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPurchaseTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPurchaseTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConvertPurchaseTransactionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ConvertedPurchaseTransactions
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ConvertPurchaseTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConvertPurchaseTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseTransactionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetPurchaseTransaction
+	XML200                        *GetPurchaseTransaction
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON406     *Problem
+	ApplicationproblemJSON503     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConvertPurchaseTransactionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ConvertedPurchaseTransaction
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ConvertPurchaseTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConvertPurchaseTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListCurrenciesWithResponse request returning *ListCurrenciesResponse
+func (c *ClientWithResponses) ListCurrenciesWithResponse(ctx context.Context, params *ListCurrenciesParams, reqEditors ...RequestEditorFn) (*ListCurrenciesResponse, error) {
+	rsp, err := c.ListCurrencies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCurrenciesResponse(rsp)
+}
+
+// ListExchangeRatesWithResponse request returning *ListExchangeRatesResponse
+func (c *ClientWithResponses) ListExchangeRatesWithResponse(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*ListExchangeRatesResponse, error) {
+	rsp, err := c.ListExchangeRates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExchangeRatesResponse(rsp)
+}
+
+// ListPurchaseTransactionsWithResponse request returning *ListPurchaseTransactionsResponse
+func (c *ClientWithResponses) ListPurchaseTransactionsWithResponse(ctx context.Context, params *ListPurchaseTransactionsParams, reqEditors ...RequestEditorFn) (*ListPurchaseTransactionsResponse, error) {
+	rsp, err := c.ListPurchaseTransactions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPurchaseTransactionsResponse(rsp)
+}
+
+// PostPurchaseTransactionWithBodyWithResponse request with arbitrary body returning *PostPurchaseTransactionResponse
+func (c *ClientWithResponses) PostPurchaseTransactionWithBodyWithResponse(ctx context.Context, params *PostPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error) {
+	rsp, err := c.PostPurchaseTransactionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPurchaseTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPurchaseTransactionWithResponse(ctx context.Context, params *PostPurchaseTransactionParams, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error) {
+	rsp, err := c.PostPurchaseTransaction(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPurchaseTransactionResponse(rsp)
+}
+
+// ConvertPurchaseTransactionsWithBodyWithResponse request with arbitrary body returning *ConvertPurchaseTransactionsResponse
+func (c *ClientWithResponses) ConvertPurchaseTransactionsWithBodyWithResponse(ctx context.Context, params *ConvertPurchaseTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionsResponse, error) {
+	rsp, err := c.ConvertPurchaseTransactionsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertPurchaseTransactionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConvertPurchaseTransactionsWithResponse(ctx context.Context, params *ConvertPurchaseTransactionsParams, body ConvertPurchaseTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionsResponse, error) {
+	rsp, err := c.ConvertPurchaseTransactions(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertPurchaseTransactionsResponse(rsp)
+}
+
+// GetPurchaseTransactionWithResponse request returning *GetPurchaseTransactionResponse
+func (c *ClientWithResponses) GetPurchaseTransactionWithResponse(ctx context.Context, transactionId string, params *GetPurchaseTransactionParams, reqEditors ...RequestEditorFn) (*GetPurchaseTransactionResponse, error) {
+	rsp, err := c.GetPurchaseTransaction(ctx, transactionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseTransactionResponse(rsp)
+}
+
+// ConvertPurchaseTransactionWithBodyWithResponse request with arbitrary body returning *ConvertPurchaseTransactionResponse
+func (c *ClientWithResponses) ConvertPurchaseTransactionWithBodyWithResponse(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionResponse, error) {
+	rsp, err := c.ConvertPurchaseTransactionWithBody(ctx, transactionId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertPurchaseTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConvertPurchaseTransactionWithResponse(ctx context.Context, transactionId string, params *ConvertPurchaseTransactionParams, body ConvertPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertPurchaseTransactionResponse, error) {
+	rsp, err := c.ConvertPurchaseTransaction(ctx, transactionId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertPurchaseTransactionResponse(rsp)
+}
+
+// ParseListCurrenciesResponse parses an HTTP response from a ListCurrenciesWithResponse call
+func ParseListCurrenciesResponse(rsp *http.Response) (*ListCurrenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCurrenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCurrencies
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExchangeRatesResponse parses an HTTP response from a ListExchangeRatesWithResponse call
+func ParseListExchangeRatesResponse(rsp *http.Response) (*ListExchangeRatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExchangeRatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExchangeRateHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPurchaseTransactionsResponse parses an HTTP response from a ListPurchaseTransactionsWithResponse call
+func ParseListPurchaseTransactionsResponse(rsp *http.Response) (*ListPurchaseTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPurchaseTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListPurchaseTransactions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 406:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON406 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest ListPurchaseTransactions
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPurchaseTransactionResponse parses an HTTP response from a PostPurchaseTransactionWithResponse call
+func ParsePostPurchaseTransactionResponse(rsp *http.Response) (*PostPurchaseTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPurchaseTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatePurchaseTransaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 406:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON406 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 201:
+		var dest CreatePurchaseTransaction
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML201 = &dest
+
+	case rsp.StatusCode == 201:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseConvertPurchaseTransactionsResponse parses an HTTP response from a ConvertPurchaseTransactionsWithResponse call
+func ParseConvertPurchaseTransactionsResponse(rsp *http.Response) (*ConvertPurchaseTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConvertPurchaseTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConvertedPurchaseTransactions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return nil
+
+	return response, nil
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+// ParseGetPurchaseTransactionResponse parses an HTTP response from a GetPurchaseTransactionWithResponse call
+func ParseGetPurchaseTransactionResponse(rsp *http.Response) (*GetPurchaseTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetPurchaseTransaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 406:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON406 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest GetPurchaseTransaction
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseConvertPurchaseTransactionResponse parses an HTTP response from a ConvertPurchaseTransactionWithResponse call
+func ParseConvertPurchaseTransactionResponse(rsp *http.Response) (*ConvertPurchaseTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &ConvertPurchaseTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConvertedPurchaseTransaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List Currencies
+	// (GET /currencies)
+	ListCurrencies(w http.ResponseWriter, r *http.Request, params ListCurrenciesParams)
+	// List Exchange Rates
+	// (GET /exchange-rates)
+	ListExchangeRates(w http.ResponseWriter, r *http.Request, params ListExchangeRatesParams)
+	// List Purchase Transactions
+	// (GET /purchase)
+	ListPurchaseTransactions(w http.ResponseWriter, r *http.Request, params ListPurchaseTransactionsParams)
+	// Create Purchase Transaction
+	// (POST /purchase)
+	PostPurchaseTransaction(w http.ResponseWriter, r *http.Request, params PostPurchaseTransactionParams)
+	// Convert Purchase Transactions
+	// (POST /purchase/convert)
+	ConvertPurchaseTransactions(w http.ResponseWriter, r *http.Request, params ConvertPurchaseTransactionsParams)
+	// Get Purchase Transaction
+	// (GET /purchase/{transactionId})
+	GetPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params GetPurchaseTransactionParams)
+	// Convert Purchase Transaction
+	// (POST /purchase/{transactionId}/conversions)
+	ConvertPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params ConvertPurchaseTransactionParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// List Currencies
+// (GET /currencies)
+func (_ Unimplemented) ListCurrencies(w http.ResponseWriter, r *http.Request, params ListCurrenciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Exchange Rates
+// (GET /exchange-rates)
+func (_ Unimplemented) ListExchangeRates(w http.ResponseWriter, r *http.Request, params ListExchangeRatesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Purchase Transactions
+// (GET /purchase)
+func (_ Unimplemented) ListPurchaseTransactions(w http.ResponseWriter, r *http.Request, params ListPurchaseTransactionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Purchase Transaction
+// (POST /purchase)
+func (_ Unimplemented) PostPurchaseTransaction(w http.ResponseWriter, r *http.Request, params PostPurchaseTransactionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Convert Purchase Transactions
+// (POST /purchase/convert)
+func (_ Unimplemented) ConvertPurchaseTransactions(w http.ResponseWriter, r *http.Request, params ConvertPurchaseTransactionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Purchase Transaction
+// (GET /purchase/{transactionId})
+func (_ Unimplemented) GetPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params GetPurchaseTransactionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Convert Purchase Transaction
+// (POST /purchase/{transactionId}/conversions)
+func (_ Unimplemented) ConvertPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params ConvertPurchaseTransactionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListCurrencies operation middleware
+func (siw *ServerInterfaceWrapper) ListCurrencies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCurrenciesParams
+
+	// ------------- Optional query parameter "country" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "country", r.URL.Query(), &params.Country)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "country", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCurrencies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) ListExchangeRates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExchangeRatesParams
+
+	// ------------- Required query parameter "country" -------------
+
+	if paramValue := r.URL.Query().Get("country"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "country"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "country", r.URL.Query(), &params.Country)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "country", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "currency" -------------
+
+	if paramValue := r.URL.Query().Get("currency"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "currency"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "purchaseDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "purchaseDate", r.URL.Query(), &params.PurchaseDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purchaseDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ratePolicy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ratePolicy", r.URL.Query(), &params.RatePolicy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ratePolicy", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExchangeRates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostPurchaseTransactionWithBodyWithResponse request with any body
-	PostPurchaseTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error)
+// ListPurchaseTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ListPurchaseTransactions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	PostPurchaseTransactionWithResponse(ctx context.Context, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error)
+	var err error
 
-	// GetPurchaseTransactionWithResponse request
-	GetPurchaseTransactionWithResponse(ctx context.Context, transactionId string, params *GetPurchaseTransactionParams, reqEditors ...RequestEditorFn) (*GetPurchaseTransactionResponse, error)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPurchaseTransactionsParams
 
-type PostPurchaseTransactionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreatePurchaseTransaction
-	XML201       *CreatePurchaseTransaction
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// Status returns HTTPResponse.Status
-func (r PostPurchaseTransactionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPurchaseTransactionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
-	return 0
-}
 
-type GetPurchaseTransactionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetPurchaseTransaction
-}
+	// ------------- Optional query parameter "fromDate" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetPurchaseTransactionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "fromDate", r.URL.Query(), &params.FromDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fromDate", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPurchaseTransactionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// ------------- Optional query parameter "toDate" -------------
 
-// PostPurchaseTransactionWithBodyWithResponse request with arbitrary body returning *PostPurchaseTransactionResponse
-func (c *ClientWithResponses) PostPurchaseTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error) {
-	rsp, err := c.PostPurchaseTransactionWithBody(ctx, contentType, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, false, "toDate", r.URL.Query(), &params.ToDate)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toDate", Err: err})
+		return
 	}
-	return ParsePostPurchaseTransactionResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostPurchaseTransactionWithResponse(ctx context.Context, body PostPurchaseTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseTransactionResponse, error) {
-	rsp, err := c.PostPurchaseTransaction(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", r.URL.Query(), &params.MinAmount)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minAmount", Err: err})
+		return
 	}
-	return ParsePostPurchaseTransactionResponse(rsp)
-}
 
-// GetPurchaseTransactionWithResponse request returning *GetPurchaseTransactionResponse
-func (c *ClientWithResponses) GetPurchaseTransactionWithResponse(ctx context.Context, transactionId string, params *GetPurchaseTransactionParams, reqEditors ...RequestEditorFn) (*GetPurchaseTransactionResponse, error) {
-	rsp, err := c.GetPurchaseTransaction(ctx, transactionId, params, reqEditors...)
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", r.URL.Query(), &params.MaxAmount)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxAmount", Err: err})
+		return
 	}
-	return ParseGetPurchaseTransactionResponse(rsp)
-}
 
-// ParsePostPurchaseTransactionResponse parses an HTTP response from a PostPurchaseTransactionWithResponse call
-func ParsePostPurchaseTransactionResponse(rsp *http.Response) (*PostPurchaseTransactionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
 	}
 
-	response := &PostPurchaseTransactionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "country" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreatePurchaseTransaction
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "country", r.URL.Query(), &params.Country)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "country", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 201:
-		var dest CreatePurchaseTransaction
-		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.XML201 = &dest
+	// ------------- Optional query parameter "currency" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "ratePolicy" -------------
 
-// ParseGetPurchaseTransactionResponse parses an HTTP response from a GetPurchaseTransactionWithResponse call
-func ParseGetPurchaseTransactionResponse(rsp *http.Response) (*GetPurchaseTransactionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "ratePolicy", r.URL.Query(), &params.RatePolicy)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ratePolicy", Err: err})
+		return
 	}
 
-	response := &GetPurchaseTransactionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPurchaseTransactions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetPurchaseTransaction
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostPurchaseTransaction operation middleware
+func (siw *ServerInterfaceWrapper) PostPurchaseTransaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPurchaseTransactionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
 		}
-		response.JSON200 = &dest
 
-	}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-	return response, nil
-}
+		params.IdempotencyKey = &IdempotencyKey
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create Purchase Transaction
-	// (POST /purchase)
-	PostPurchaseTransaction(w http.ResponseWriter, r *http.Request)
-	// Get Purchase Transaction
-	// (GET /purchase/{transactionId})
-	GetPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params GetPurchaseTransactionParams)
-}
+	}
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPurchaseTransaction(w, r, params)
+	}))
 
-type Unimplemented struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Create Purchase Transaction
-// (POST /purchase)
-func (_ Unimplemented) PostPurchaseTransaction(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Get Purchase Transaction
-// (GET /purchase/{transactionId})
-func (_ Unimplemented) GetPurchaseTransaction(w http.ResponseWriter, r *http.Request, transactionId string, params GetPurchaseTransactionParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ConvertPurchaseTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ConvertPurchaseTransactions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConvertPurchaseTransactionsParams
 
-// PostPurchaseTransaction operation middleware
-func (siw *ServerInterfaceWrapper) PostPurchaseTransaction(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "ratePolicy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ratePolicy", r.URL.Query(), &params.RatePolicy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ratePolicy", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPurchaseTransaction(w, r)
+		siw.Handler.ConvertPurchaseTransactions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -545,33 +2507,43 @@ func (siw *ServerInterfaceWrapper) GetPurchaseTransaction(w http.ResponseWriter,
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetPurchaseTransactionParams
 
-	// ------------- Required query parameter "country" -------------
-
-	if paramValue := r.URL.Query().Get("country"); paramValue != "" {
+	// ------------- Optional query parameter "country" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "country"})
+	err = runtime.BindQueryParameter("form", true, false, "country", r.URL.Query(), &params.Country)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "country", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "country", r.URL.Query(), &params.Country)
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "country", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "currency" -------------
+	// ------------- Optional query parameter "countryCode" -------------
 
-	if paramValue := r.URL.Query().Get("currency"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "countryCode", r.URL.Query(), &params.CountryCode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "countryCode", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "currency"})
+	// ------------- Optional query parameter "currencyCode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currencyCode", r.URL.Query(), &params.CurrencyCode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currencyCode", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "currency", r.URL.Query(), &params.Currency)
+	// ------------- Optional query parameter "ratePolicy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ratePolicy", r.URL.Query(), &params.RatePolicy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ratePolicy", Err: err})
 		return
 	}
 
@@ -586,6 +2558,43 @@ func (siw *ServerInterfaceWrapper) GetPurchaseTransaction(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ConvertPurchaseTransaction operation middleware
+func (siw *ServerInterfaceWrapper) ConvertPurchaseTransaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "transactionId" -------------
+	var transactionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "transactionId", runtime.ParamLocationPath, chi.URLParam(r, "transactionId"), &transactionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConvertPurchaseTransactionParams
+
+	// ------------- Optional query parameter "ratePolicy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ratePolicy", r.URL.Query(), &params.RatePolicy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ratePolicy", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConvertPurchaseTransaction(w, r, transactionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -699,12 +2708,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/currencies", wrapper.ListCurrencies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/exchange-rates", wrapper.ListExchangeRates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/purchase", wrapper.ListPurchaseTransactions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/purchase", wrapper.PostPurchaseTransaction)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/purchase/convert", wrapper.ConvertPurchaseTransactions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/purchase/{transactionId}", wrapper.GetPurchaseTransaction)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/purchase/{transactionId}/conversions", wrapper.ConvertPurchaseTransaction)
+	})
 
 	return r
 }
@@ -712,26 +2736,96 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/6xWW4/bNhP9KwS/71Eby7e96Km5IVigaBdpghZI80CJI4m2RNK8SHIW/u8FKcmWbXnj",
-	"NH2zRfLwzJmZM3zGiSil4MCNxtEzVrCxoM0bQRn4D28VEAO/Qf1kVZITDZ8U4Zokhgnu1hPBDXDjfhIp",
-	"C5YQtzJZ6XZZJzmUxP2SSkhQpoMlpbDtKbOVgCOsjWI8wwFubrQRsmBZ7pcZxRFeNWw63ZisNpRavNsF",
-	"mIJOFJM9i5I0vwLPTI6jZRhcham44vksKdcs5hzvHKgLnimgOPpydEHQ0/26hxbxChLjjrUHtRRcDxX7",
-	"Wbn+ryDFEf7f5JCfSbuqJ0NMd/0QqSmLfwt0Kit+35BSFoD68PAuwB/A/LeVkAhegTJA34EhrNDf4/y2",
-	"39/TeFIs8dTMgc+VWKdCDgtgBC04JztWEacyjkuGalYUSIGxiqN+HQ030PYOFBMNFKVCoYxVwFHialFt",
-	"EeEUJVYp4MnW0+/C8kU4rtJP9uHtenWn72ffSps1sb+y43ItQGMXhUn5plY0m7YAfQBXIuh1eMfT+ewO",
-	"MrH0CNAkOeEZfCQG3hED1yKFMpwSE7P7abqsz5A+a1cG1yE93E7F/cJIOlvcwbmV7EM8yDVy195kRiJy",
-	"VcZM4YhcSOxpGY4TXbDbZUNWso5tPPMhn/TwWHE88s9/vHN/U6FKYnCEqbBxMbjzRXG2mdlaxR/qUhRN",
-	"691dkg54xMCNYeW1kKJIH3im65iuFvOxcXANSFGu0zWst5tkbu48CBtJ+GkumUuUDyA4kueYwiBfQ4Gv",
-	"S5JgD3ldhopsbvUUtwOG8VT0HksSvxdKwgoc4ZJwpvPZPMmJLUhGGP8lc0uvElHiAHNSuhuBUgbhbI7P",
-	"3Ol3CRy9fnpEWkLC0s62vd38+f4v9On1BzSwQr8TH8KrobkxJLsxR2FWoHSLPn0VuiuFBE4kwxGev3Kf",
-	"AiyJyX2RTWRXyL7+hPbBHVNsJ6pGBHGoUb//iJbzwtZL9dF3urduV9g+tEcn8pPQo3MsGLyAtpfGx9Ej",
-	"afLiC+n0cTALp5dRu32Tyy8I7/K2LImz3E6X0eFx6SG1qsJvq3W+XNxX29ap9vpPngfCPdKdO5TBSDre",
-	"+HlkpeCjudBIKqiYsLrYIm3jkhkD1GdIG6GABi5TikEFyOTQfbwAtR+4yIh+2DHQSFsphf8ebz3KJwVE",
-	"W7VFH8EtMJ6hj75oRIred37qi9dP07+5p+8O9maLlNPS3VuBr3636DrdIbjfe4IsRaQirCCtCR4X1oX3",
-	"kSt4RUowoDSOvpxK2o90d2+dsyQ/3Na6DNK5sAVFMbTiVX5iMHd2Y8FPlK7TDyPm4FtGWQgGL7Azjzvj",
-	"042sMULD9jqwGibqErPDHLye2tezBgq/30AXkrAL8CJcnBf08NHFhUGpsJye9NoHMD/SaA2larOe5TkP",
-	"WeGhXsz/UFPWO1lb3IlrclfOQ+/rFXYuehD4qH9/TGUfLKiqp2eVmy25MTKaTAqRkCIX2kT3YRhil5Ox",
-	"mJcFf1ivqs1mprh7Bv0TAAD//wT4E5VcDgAA",
+	"H4sIAAAAAAAC/+w9aXPbOJZ/BcWdKu/O0rJ85PKnzSSenszR7XKSnd1t96og8klCTAI0AEpWUvrvW7hI",
+	"kASpw46re2c+xSJB4OHdF5BvUcLyglGgUkSX3yIO9yUI+QeWEtAP3jG6BC6vS54ssIBPHFOBE0kYVW8T",
+	"RiVQqf7ERZGRBKs3J1+EeS2SBeRY/VVwVgCXdlKJ+RzMgkRCrv/4HYdZdBn9y0kN0In5XpwYIARh9JP+",
+	"MtrEUY4fPphvz8ZxlBNqf53GkVwXEF1GmHO8jjabWO+KcEijy5+rtX+pxrHpF0hktNmoof37FY/YcMJK",
+	"Kvla/ZmCSDgpDAbdCzRjHK0WJFmgwq6McK7eCSQWrMxSNAXEQXKyhDSqQBeSEzpX6EhKzoEmoSXsGyTZ",
+	"9iUSs/3wGrJGxoe0Sb3O2Jo8L8b70ae5SFzhzttjH+04YAk/wuppudVgqYtY8xyxGZILqJFKKPr88f0I",
+	"3dhNoZJmIARinMwJxdlb8xmmafXonSMR5oAKzpYkhXTUIUEcPRwLyYqMzBcaHpJGl9GXB3J6ei/nK5mm",
+	"pUZnA8xvihR/BTqXC0WL3ebklNPFWZLfkSmles4m9PsgQ/2ueJBItMIC5ThV70boneM3xZ2fP75HKyIX",
+	"+hPJAYuSrxE8JAtM54A4ltBZIMUSRiFmbeO2C/KHjz+hi7PTVyhhaTVxLS3+Kh7MCEbzETq6+nxz5NEY",
+	"Z4zODfBNTI22CNJ7LKELWqq3Sq28BkEZofcww2UmhUKdxhjJq21YPa65LMGUMommFTVmpSy5xtqM8RxL",
+	"u+CxmmBHprs/W75aJPn4q7ybQrRpC7G/m6Cw6vGiYFQ0zAykTyu6SWU49jA2lgNqo3MDosy00WlqriYh",
+	"QWKSbZ3d39eA6nOzxY0thFDZEvdBTKIVyTLEQZacNrnKWxqlZm2fpw1LKSwoBgOcLByHQYpqCKNNPLj+",
+	"Y0yoWX53MnrLbqdkixBurcfiW3QQXuPKx+cS+NpDqE8Mkjqprd8zngKPKoP3WJHZh139mR7y7PCJJDzI",
+	"k0QsmzM0UbsAnAJHM5ZlbAUpmq4RRoLQeaawkTCe1tyZsKzMqUAkjbVNiJE3l1aDxkJ9oJ8/vu/q5C5d",
+	"rx5wXuiFjJpS+L6ypugGS/gTEZLx9V6Y7nUKu+6ceed00XsQSXicZ906LyEjczLN4I+c5V3ssiwFIR0i",
+	"tcVxH2h31OkGZaFG6CearS0jQ4pWC6CNAYiIynEJWTw38yfWBSTD8vkAmVlcdF7Yj3gXPopru9p0Rtw3",
+	"1k4L4EtIjbCqPY3Qj0y2YJUL4BpIyswcTrrVrCGA/c0FAVezXLOMJOttMnhTj7Tf7a5Ofda/ZoSGzSEL",
+	"QNjSrQF3PszullZ6VgftLgo5IKQdNdwgo1DExcjCYFwmCwgqMOFayRCKsOFNQ6lNHP0A8nt4KxLSHX2J",
+	"jum55iSBZ/VLPGA7tIkjayGUAEWXffjabLUr/8SSxdKzGs3Yt5ixE4+4ko0YgSdpnwWkzSfv9YS4jnYr",
+	"DbuD9Q1joSHH7j36FPBdp1hAqq3HnCyBBoVbscBfiZBW69TsdYjsVkp0J236sSwKptjinQfLoEtqpt1F",
+	"/zW3FPBAe9ScQMJBpZim39oJh7gn9vD3Q2FgcQWTyjKFTBOFB4UVwXgwQyYYR5KhGUgbb6vxqMBzaBlx",
+	"ZrCYYWFeB5h5J8K1xb4Xn/uqx39isReLB6tPqzeVOtGxr2yopOdWpnElibEXT15xzriR6uazdyyFXVRu",
+	"H+4aGgRrcimHSUjGIQ1mEEQUWzxq/vsRHuTxQVwTI6F8byxQzXjbOakmbWfPmzi65myaQT6gpwoz4t/3",
+	"i5TdvAHMfvIScjNMMkhH6COA5ZrURDlSD8LCehZ22jo35lU/nrC88HTVhcASvpgESgxIsu5a26MFpQSI",
+	"zCC67GKmYx7jqMf9G8jy75L/fHn35ZV4ffY1L+cPUw11bzQfnuChvMjkjN6veDo/9SfQ4hrMVZ+fvnx5",
+	"fIpwVizw8Vkza23prEPMO8pWNEjFcFKhuVKVd3ePGW+v0nAddDr8MyWKoH8hdJ6y/PialXQrH+2CJXE3",
+	"fkVn52evYM5eRBtvhn409af0h7HTVre7wjguxqdYTsnr09mLVbRpzaRU+a4zvXl5yl5fyCI9u3gFeqan",
+	"TUo0xo0M5Ry5YwTJFDGOZiTbtQjwerY6p/en2V06XmMjuofmIhTDEDr/m6Xq4Jf+WKUpWckT6KtLuWoM",
+	"qgtUuNZQrqaDayZhcgFKG2NdxostFonw9daCs3K+UO8RoUICTvW8tYUf3VKl9O2aRCAfSPT7jrFHJ3bE",
+	"VccLUBgo1arK0WClRA5XmqSESuA5pERRXsFjlrylSkb1SFNaU2NzQhlHJSVSdEWjyvJ72EVM5doYTQBh",
+	"qd8CTUe3Qekx4D91sa1/pauAtDZX9POJdj3zaVMOYoSzFV4LBPclzhSuOopgJyCcoDeBCBYvLRh+YV6z",
+	"moG4dmq086Phx6LDMttNZye9FgVUU+wMX0ABdixtx4oG/PGAnrggL1884C/FalpOzzScvTW275jtGUy3",
+	"D6bROQ95rsZZQ6tFi30T7ejYiqvv7Ohhpv0EecQJrxe2bzlOFoRqRzHFKj+uxzZkSj8x/qV1SfXrIBNL",
+	"LEvRXeVPnz5dI/Oy5WU4ao3Q2XhcJbSbohtoIVFaag58t1ywhcpnvj5uCbh73Tx1h6VccaG78dUCrPa3",
+	"AptgqqhYCkNAu7WOBWH0KYoUU8YywLTtiwz1WjS871MULG3FVhP2FBA6pc9qbAsMjyBdJAco8YEucUbS",
+	"a8xx3iWCCdiHvJr7EvgaMY4KLBeoUNOAVDGvDZb+/PGnH1HBtAVstz1MWbpGMwJZGsYHtqHdMC40jNVw",
+	"b/+NrQW27sWYzf3d/PEdevV6/ArZGLPKV1a8oghp6sEmUnRbiuKOYgwpCCG1WhjQEyP0LiPgdX3lWMXd",
+	"jNbhqOfTqGcGRsUOtMx1MkahnOJsomeN4miK00kNJ2VyMrMRQA5ywdKJeoRNRiWKI408HWtPzCajOCIG",
+	"pW6aiaJgFEclrZKSE+3mTDSy48gfN5GMTTKlWO3qOEmg0JjwZvai0QnRfOE98GF2H1SG0T3wlFRo0tQI",
+	"jXul+XdS8W1zHsEaDyrFNpEukHWyN+FYQgO+5puS4iUmmd1s853y/UnSHkNSyAsm1U4md7CecCiNJ9B+",
+	"Qeik4GzOQfipt1qOLGN0c2hljqnHew9FhqmmNxIFJGRGEmMOiUAssVitW7us8PRZxoDBWhKW6fkrx/an",
+	"Aujb6w/VemZ1jUGX3Ha6wnIj48oBs/lvJYVBLt0lp/qfDppQBlXJFqZJQHS1mmvpMRN8JNgYoGHkEE8p",
+	"iZC7YhNQSisKO7PVMfVWd91jQwMGtrmva1F1WXR9hkrttucSC8YlajGbKPMc87Wb1+lZqzU6SDMP2jN/",
+	"vvmAOMzA8CVJgUoyW7uYy5/TRtElp5creDiWeH5pX1/2qZdhm+MA1Vuu8Bgbde+ZoOs2I3jWZyCnP9Ah",
+	"d7WTm+vtaoun6zWIDTi7oWT1IW5vPc8+HvBvqJbs036AwAF+uGkkZVpKRz9HAjJIpGPwTtCKG2ljO4rr",
+	"1okqZsU6cxBozkUqE5IxdjfFyR1aEZqyFWKzW2r6fiaMThifTGHGOMSIAuaucRUwz4gagmcSuM3BzMi8",
+	"5F6MrNJbpu6Rev2wL1HOqFyI0S1F6PcosBQ6tk/NLv3Ym3Fkx3R243pBZHdPZiUH/3FrWvdcssCkimmJ",
+	"jjwESYfWiPVDgxduyUMRRpKAWV7gHCb3JeYKYT079KZPcAY0xRy5T0Lt1WbmFjGOqwdB/JlB+6DP8y8D",
+	"1IriyGIwiiN/k+qzBmS+oHicHxD+m1bSsaX37FvN/9Sl8iz5qmxWK6em/mlmxs9QCgnJcYaKDCcgtGuh",
+	"sz2UUVP5+fP1f2v+Pde//vJ3lz3MtS++B9MvcDablIXlefsLHeu/EF7hNVIdVegrcOYNgSVQN8huzzGr",
+	"fpWSOZEqSyaYSZ4jrPor6B3wI1HlC82EqXp9jCQvaYKNLVhhngq9pkdhC1sURxUIURyprxvk8wkUIGC3",
+	"i2L3ylhV5rAj1NYLLITanF/rMFFo0Yz0uiWVRxRt4qeo2gRLM18YoaaEfXR8pDZWRZnTdfOMhXKVzbmG",
+	"UCXnaL+SYI1aL8HZwK17vg25h9d6tiF1RriQN428SOgEhpnUdspWMZTWeKFZM7z7pFY17zKpGhjOBAUn",
+	"cWjeN030qGKTeqWbJYlwJafp+pDybm8zaItiHWw3MOXtxlMoXYURcJdavZyhKrFpIr/85p2fYeV057rZ",
+	"ei7XJadvVjnLHjROUkvdg8/jsGz2hs7Fapp+uTgPHQLbZZIsv5vdwd36PjmXr/QkJA1mxL/TYTCdNRUg",
+	"G13fYnuRrnmSKUyJZzoRNrTu1WBSd/i8m5ujXTSycbzHlhqyFHQrh3a3doZp5zJaE0KXHDdrNqqg29u6",
+	"0sgyfxw1z2e0T5BZCfaFc7fiEyNvFqt8zPH9S3EabdodY+0obOjY0HcsTsETB9//2GWmR4fjrbPO20sF",
+	"zeGhItYQYwWsUJ1E7HZ40oAjwGyK0+ncRrnElEMYD9RHhOeVqwxkFEfaK6ta+LRBZndEkVZ9FswEb6/m",
+	"/KrqNzVyg/3UhM6YFW+JEy33kOtMd5RjSsTi7DxZ4DLDc0zof8zVq1Gij6pYpQJpSmB8dh617XCk8tIo",
+	"lJjm6O9X/4U+vf2hIeRqZFTDbVOMx7KhBS0vRZfR6WislmQFUFyQ6DI6H6lHsaas5p2TpNHsbrsJW0Uq",
+	"HSUIW4bqPxZTd/xZqXVRak/bOFqwLNXPRGzOMJpIxK4wQldYdQ9iwm+pycSL+miUDsNrN7c2SkJD5nnU",
+	"pqlDlBoubPP74W0scVaCcFXelIiELQ1UJv1jdYzKAtQpMGXVlDhipxvahwjiqGJ2EV3+3EYvq2vDldes",
+	"8vFM1FGnkFjtvurKMYcYCg4z8hCbih2kKDFOlQAqiCRLyExlKrqsRNjyY+1m9/fG/tI6kX02HvepzGrc",
+	"SWvnmzi62OWzqlc2jmz+Yo9vlEUxSX6LeuRDsImjE8d/x9URt0E+r87OC+DEnAbrng8bPDoRzK3Vh8Ua",
+	"3B44dqlDm9Et/ftQn0Bs5ZHb5znmd3ZB5PoWiO41qw5PDncsaOHQ65c09ZseTE44vqXar901MYqpAcbu",
+	"3iVg3QdEVl3OluTBpKxZOyxivq+6Vco6+Z2Q7yq2ykttTiQvYUh+4u1pkL1AqD23R8Dg65pWvr6ZpyXC",
+	"Juv9SyVO0RpwRfIjyY56gLUHNWvAGnFsFD8BoBXfBSGVLMV9yk+yx4HWZPOm0TOiRjIi195Bfiuhrprc",
+	"A5cv6I+E0BRxput9IeveIWKn6ks592yF+3n23Y5L+P2/h9me0GH8AwzQi/H5c58FafpHtklD0UWV47Wz",
+	"QVOkj4hLVaHJ9B1CkmRKiSqxWNozeE9hOx0WkdGq2n46ztxqOYfOAPk2j8IKhGwZDGP1kAmBhLn6CKuk",
+	"hFT7Fa4YyQp8X8IttQeFqhQ2oeioPhV0NELaek6ZXITttH+1krOljYs2nDc2h1a3N/NcMDdd2EIFj55t",
+	"MVTtfdnUfMFhSVgp3KGmHhth+oj2sAg5fiB5mSNa5lMbd/lEk8xC0rNkRnIiGytWTKivYTOzR5en9tIv",
+	"+yvUCTroF/swOYela64GzNGwVg2nVg8Eqm2aeu3Q94ZJ+4LuzIHOjKI5ByxdqpTxusldQ+t1uHXhzQl9",
+	"694f6HJsg07fi3YIaPjhaUHTwZc3GOnQn+jggAikDo6if22HW//WA52/6F7w7XUZYKWgRuhvpdBZQKfe",
+	"/JubGj2Lh0WG8dPcKLgFzgqQLd7wHnDu1tzi0hxO31dx/m/ZOeo/CL2/h3QxfvnsIX3ocgcNfMFEwCEx",
+	"F2EJhJWrET6Aqg9EuYi/e2OE9R+OPtQ9sMd/gfURsgfCG5E4B8mrdkCbs7ylVbZGn9u5A+OA6FSmPUJd",
+	"ZHjdLOc4ZPg914najMk5paVxRRtb6foe1yxI7W2uR0nJfWkgnQNVE9b+R6KbxBXnCzwDozd5o3HWsXuV",
+	"Jrb83sJgg+m9qyjPXrzQHoL7fRqHU1LuPtp1Pz95V9aeDN4BuumI0ul2Lu2/ZO0ZZOli/OY5o5O3dedx",
+	"g5m9znDNLkQgIRVPT0ExasFZAkJAqkE+O3vugKoN3gILhDMOOF2jKQA1NUq9JYxSMtOdvbJRbXgCxWUY",
+	"Jai6+q5u/bIcf/1yt3hx8Xq5jjaN4OvEWiQ1uEfpmQEmgTkYilXErCwvSbVVq24G6k1tmvbN1l2Ft7R9",
+	"RWGdU0CEpmRJ0hJn2Vr1K5YCYYpKanrHWh8y7qcmdUqyvq60tskpAxMOqwhZ72S1YBmgqcqEd/Xh0CXO",
+	"W3TiPj7Dr9JT2FdfDqBqc4jnMXz/5/NXCCw8fR5FQ+S+Ncq4m978xx/07VJl4Z37awqci95VP0s5zYlU",
+	"TKxky4ip8SAILGG77DYSEXX1rntZ0yeXb77Rkqh498ZVL6osjyoo6ruxbqkGv8vdat1lfSVJGrp0mXi1",
+	"OKMgbL8BETZPYtuZ232GITVzS1k1UDXgeC2VatwJ48hvBHQn8nMVTFSKIp8SCmlXE/TcprZv8WCf+1N2",
+	"i8fcreD1rlMwSTB3GOuporVuHeKgq1p2Ct8O29VBwd2ON6LoGtYPf9BdB1efK7aGkjP0lVEYobcZo7o+",
+	"ZXS+aNSo2rWbqmc3QFLNsa15vOoTRmKBefM4zACR7bVNNUYKLCVw9cH//vz2+H/w8ddfvp1tfhfFu+Gq",
+	"v6/Nouh6hN7WD7XHNFWGcAkcZ3YjRvEkC4QFuvp8gzgIli3B+hIV0prM4Au0UxBbWGGnzZ/vtvkdLPoB",
+	"h1p+w7mBvhsmD4lmLp7V1a+hNc6gIs4hUdU/bs3nB5D7BCgPacrv784WCzommZ5qi91sOPd+TaNKavgJ",
+	"GicVtvHMJcpbvXS7179/GfLmTlr/GcCveyfxI4M+JJm5q7DZWQfp0AWghHoRIc4y25JVI65uzNIHnlbu",
+	"FnsX5/nN0juHcqaZ26POPgHdU8ZzfuPp/8eI7ukDut+y0fiOcaWBw3CGYcqSZ9FltJCyuDw5yViCswUT",
+	"8vL1eDyONr+Ele+LjL65+7K8vz/jFKLN5v8GANF6r72sawAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
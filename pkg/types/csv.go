@@ -0,0 +1,77 @@
+package types
+
+import "time"
+
+var (
+	transactionCSVHeader         = []string{"id", "date", "description", "amountInUSD", "originalAmount", "originalCurrency", "originalExchangeRate", "originalExchangeRateDate"}
+	convertedPurchasePriceHeader = []string{"country", "currency", "exchangeRateUsed", "exchangeRateDate", "amount", "provider", "countryCode", "currencyCode", "ratePolicy", "roundingMode", "sourceAmount", "sourceCurrency", "sourceExchangeRateUsed", "sourceExchangeRateDate"}
+)
+
+// MarshalCSV will return the header and the record of the transaction.
+func (t Transaction) MarshalCSV() ([][]string, error) {
+	return [][]string{transactionCSVHeader, t.csvRecord()}, nil
+}
+
+// MarshalCSV will return the header and the record of the converted purchase transaction, with the transaction
+// details followed by the converted details.
+func (g GetPurchaseTransaction) MarshalCSV() ([][]string, error) {
+	header := append(append([]string{}, transactionCSVHeader...), convertedPurchasePriceHeader...)
+	record := append(g.TransactionDetails.csvRecord(), g.ConvertedDetails.csvRecord()...)
+
+	return [][]string{header, record}, nil
+}
+
+// MarshalCSV will return the header and a record for each purchase transaction of the page. Columns of the converted
+// details and the conversion error are left empty when not available.
+func (l ListPurchaseTransactions) MarshalCSV() ([][]string, error) {
+	header := append(append([]string{}, transactionCSVHeader...), convertedPurchasePriceHeader...)
+	header = append(header, "conversionError", "conversionErrorCode")
+
+	records := [][]string{header}
+	for _, item := range l.Items {
+		converted := make([]string, len(convertedPurchasePriceHeader))
+		if item.ConvertedDetails != nil {
+			converted = item.ConvertedDetails.csvRecord()
+		}
+
+		record := append(item.TransactionDetails.csvRecord(), converted...)
+		record = append(record, stringValue(item.ConversionError), stringValue(item.ConversionErrorCode))
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (t Transaction) csvRecord() []string {
+	return []string{t.Id, t.Date.Format(time.RFC3339Nano), t.Description, t.AmountInUSD, stringValue(t.OriginalAmount), stringValue(t.OriginalCurrency), stringValue(t.OriginalExchangeRate), stringValue(t.OriginalExchangeRateDate)}
+}
+
+func (c ConvertedPurchasePrice) csvRecord() []string {
+	return []string{c.Country, c.Currency, c.ExchangeRateUsed, c.ExchangeRateDate, c.Amount, stringValue(c.Provider), stringValue(c.CountryCode), stringValue(c.CurrencyCode), ratePolicyValue(c.RatePolicy), roundingModeValue(c.RoundingMode),
+		stringValue(c.SourceAmount), stringValue(c.SourceCurrency), stringValue(c.SourceExchangeRateUsed), stringValue(c.SourceExchangeRateDate)}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func ratePolicyValue(p *RatePolicy) string {
+	if p == nil {
+		return ""
+	}
+
+	return string(*p)
+}
+
+func roundingModeValue(r *RoundingMode) string {
+	if r == nil {
+		return ""
+	}
+
+	return string(*r)
+}
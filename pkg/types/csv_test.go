@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestMarshalCSV(t *testing.T) {
+	date := time.Date(2023, 12, 1, 10, 58, 37, 0, time.UTC)
+	provider := "treasury"
+	countryCode, currencyCode := "NP", "NPR"
+	ratePolicy := RatePolicyLatestOnOrBefore
+	roundingMode := RoundingModeHalfUp
+	conversionError := "the purchase cannot be converted to the target currency"
+	conversionErrorCode := "exchange_rate_not_found"
+	originalAmount, originalCurrency, originalExchangeRate, originalExchangeRateDate := "9.3", "EUR", "0.918", "2023-09-30"
+
+	transaction := Transaction{Id: "ae90db91-d278-4941-b2b0-92e3b6f666e2", Date: date, Description: "foo", AmountInUSD: "10.13"}
+	converted := ConvertedPurchasePrice{Country: "Nepal", Currency: "Rupee", ExchangeRateUsed: "130.5", ExchangeRateDate: "2023-09-30", Amount: "1321.97", Provider: &provider, CountryCode: &countryCode, CurrencyCode: &currencyCode, RatePolicy: &ratePolicy, RoundingMode: &roundingMode}
+
+	tests := []struct {
+		name string
+		give interface{ MarshalCSV() ([][]string, error) }
+		want [][]string
+	}{
+		{
+			name: "transaction",
+			give: transaction,
+			want: [][]string{
+				{"id", "date", "description", "amountInUSD", "originalAmount", "originalCurrency", "originalExchangeRate", "originalExchangeRateDate"},
+				{"ae90db91-d278-4941-b2b0-92e3b6f666e2", "2023-12-01T10:58:37Z", "foo", "10.13", "", "", "", ""},
+			},
+		},
+		{
+			name: "foreign currency transaction",
+			give: Transaction{Id: "ae90db91-d278-4941-b2b0-92e3b6f666e2", Date: date, Description: "foo", AmountInUSD: "10.13", OriginalAmount: &originalAmount, OriginalCurrency: &originalCurrency, OriginalExchangeRate: &originalExchangeRate, OriginalExchangeRateDate: &originalExchangeRateDate},
+			want: [][]string{
+				{"id", "date", "description", "amountInUSD", "originalAmount", "originalCurrency", "originalExchangeRate", "originalExchangeRateDate"},
+				{"ae90db91-d278-4941-b2b0-92e3b6f666e2", "2023-12-01T10:58:37Z", "foo", "10.13", "9.3", "EUR", "0.918", "2023-09-30"},
+			},
+		},
+		{
+			name: "converted purchase transaction",
+			give: GetPurchaseTransaction{TransactionDetails: transaction, ConvertedDetails: converted},
+			want: [][]string{
+				{"id", "date", "description", "amountInUSD", "originalAmount", "originalCurrency", "originalExchangeRate", "originalExchangeRateDate", "country", "currency", "exchangeRateUsed", "exchangeRateDate", "amount", "provider", "countryCode", "currencyCode", "ratePolicy", "roundingMode", "sourceAmount", "sourceCurrency", "sourceExchangeRateUsed", "sourceExchangeRateDate"},
+				{"ae90db91-d278-4941-b2b0-92e3b6f666e2", "2023-12-01T10:58:37Z", "foo", "10.13", "", "", "", "", "Nepal", "Rupee", "130.5", "2023-09-30", "1321.97", "treasury", "NP", "NPR", "latest_on_or_before", "half_up", "", "", "", ""},
+			},
+		},
+		{
+			name: "page of purchase transactions",
+			give: ListPurchaseTransactions{
+				Items: []PurchaseTransactionListItem{
+					{TransactionDetails: transaction, ConvertedDetails: &converted},
+					{TransactionDetails: transaction, ConversionError: &conversionError, ConversionErrorCode: &conversionErrorCode},
+				},
+			},
+			want: [][]string{
+				{"id", "date", "description", "amountInUSD", "originalAmount", "originalCurrency", "originalExchangeRate", "originalExchangeRateDate", "country", "currency", "exchangeRateUsed", "exchangeRateDate", "amount", "provider", "countryCode", "currencyCode", "ratePolicy", "roundingMode", "sourceAmount", "sourceCurrency", "sourceExchangeRateUsed", "sourceExchangeRateDate", "conversionError", "conversionErrorCode"},
+				{"ae90db91-d278-4941-b2b0-92e3b6f666e2", "2023-12-01T10:58:37Z", "foo", "10.13", "", "", "", "", "Nepal", "Rupee", "130.5", "2023-09-30", "1321.97", "treasury", "NP", "NPR", "latest_on_or_before", "half_up", "", "", "", "", "", ""},
+				{"ae90db91-d278-4941-b2b0-92e3b6f666e2", "2023-12-01T10:58:37Z", "foo", "10.13", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", conversionError, conversionErrorCode},
+			},
+		},
+		{
+			name: "empty page of purchase transactions",
+			give: ListPurchaseTransactions{Items: []PurchaseTransactionListItem{}},
+			want: [][]string{
+				{"id", "date", "description", "amountInUSD", "originalAmount", "originalCurrency", "originalExchangeRate", "originalExchangeRateDate", "country", "currency", "exchangeRateUsed", "exchangeRateDate", "amount", "provider", "countryCode", "currencyCode", "ratePolicy", "roundingMode", "sourceAmount", "sourceCurrency", "sourceExchangeRateUsed", "sourceExchangeRateDate", "conversionError", "conversionErrorCode"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.give.MarshalCSV()
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// The generated types are only tagged for JSON, thus each response type is converted to and from an XML-tagged
+// copy of itself. Converting between the types fails to compile whenever the generated fields change.
+
+type transactionXML struct {
+	AmountInUSD              string    `xml:"amountInUSD"`
+	Date                     time.Time `xml:"date"`
+	Description              string    `xml:"description"`
+	Id                       string    `xml:"id"`
+	OriginalAmount           *string   `xml:"originalAmount,omitempty"`
+	OriginalCurrency         *string   `xml:"originalCurrency,omitempty"`
+	OriginalExchangeRate     *string   `xml:"originalExchangeRate,omitempty"`
+	OriginalExchangeRateDate *string   `xml:"originalExchangeRateDate,omitempty"`
+}
+
+// MarshalXML will encode the transaction using the element names declared in openapi.yaml.
+func (t Transaction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(transactionXML(t), start)
+}
+
+// UnmarshalXML will decode the transaction using the element names declared in openapi.yaml.
+func (t *Transaction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v transactionXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	*t = Transaction(v)
+
+	return nil
+}
+
+type convertedPurchasePriceXML struct {
+	Amount                 string        `xml:"amount"`
+	Country                string        `xml:"country"`
+	CountryCode            *string       `xml:"countryCode,omitempty"`
+	CountryCurrencyDesc    *string       `xml:"countryCurrencyDesc,omitempty"`
+	Currency               string        `xml:"currency"`
+	CurrencyCode           *string       `xml:"currencyCode,omitempty"`
+	ExchangeRateDate       string        `xml:"exchangeRateDate"`
+	ExchangeRateUsed       string        `xml:"exchangeRateUsed"`
+	Provider               *string       `xml:"provider,omitempty"`
+	RatePolicy             *RatePolicy   `xml:"ratePolicy,omitempty"`
+	RoundingMode           *RoundingMode `xml:"roundingMode,omitempty"`
+	SourceAmount           *string       `xml:"sourceAmount,omitempty"`
+	SourceCurrency         *string       `xml:"sourceCurrency,omitempty"`
+	SourceExchangeRateDate *string       `xml:"sourceExchangeRateDate,omitempty"`
+	SourceExchangeRateUsed *string       `xml:"sourceExchangeRateUsed,omitempty"`
+}
+
+// MarshalXML will encode the converted purchase price using the element names declared in openapi.yaml.
+func (c ConvertedPurchasePrice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(convertedPurchasePriceXML(c), start)
+}
+
+// UnmarshalXML will decode the converted purchase price using the element names declared in openapi.yaml.
+func (c *ConvertedPurchasePrice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v convertedPurchasePriceXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	*c = ConvertedPurchasePrice(v)
+
+	return nil
+}
+
+type getPurchaseTransactionXML struct {
+	ConvertedDetails   ConvertedPurchasePrice `xml:"convertedDetails"`
+	TransactionDetails Transaction            `xml:"transactionDetails"`
+}
+
+// MarshalXML will encode the converted purchase transaction using the element names declared in openapi.yaml.
+func (g GetPurchaseTransaction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(getPurchaseTransactionXML(g), start)
+}
+
+// UnmarshalXML will decode the converted purchase transaction using the element names declared in openapi.yaml.
+func (g *GetPurchaseTransaction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v getPurchaseTransactionXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	*g = GetPurchaseTransaction(v)
+
+	return nil
+}
+
+type purchaseTransactionListItemXML struct {
+	ConversionError     *string                 `xml:"conversionError,omitempty"`
+	ConversionErrorCode *string                 `xml:"conversionErrorCode,omitempty"`
+	ConvertedDetails    *ConvertedPurchasePrice `xml:"convertedDetails,omitempty"`
+	TransactionDetails  Transaction             `xml:"transactionDetails"`
+}
+
+// MarshalXML will encode the list item using the element names declared in openapi.yaml.
+func (p PurchaseTransactionListItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(purchaseTransactionListItemXML(p), start)
+}
+
+// UnmarshalXML will decode the list item using the element names declared in openapi.yaml.
+func (p *PurchaseTransactionListItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v purchaseTransactionListItemXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	*p = PurchaseTransactionListItem(v)
+
+	return nil
+}
+
+type listPurchaseTransactionsXML struct {
+	Items      []PurchaseTransactionListItem `xml:"items"`
+	NextCursor *string                       `xml:"nextCursor,omitempty"`
+}
+
+// MarshalXML will encode the page of purchase transactions using the element names declared in openapi.yaml.
+func (l ListPurchaseTransactions) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(listPurchaseTransactionsXML(l), start)
+}
+
+// UnmarshalXML will decode the page of purchase transactions using the element names declared in openapi.yaml.
+func (l *ListPurchaseTransactions) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v listPurchaseTransactionsXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	*l = ListPurchaseTransactions(v)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestXML(t *testing.T) {
+	date := time.Date(2023, 12, 1, 10, 58, 37, 0, time.UTC)
+	provider := "treasury"
+	conversionError := "the purchase cannot be converted to the target currency"
+	nextCursor := "next"
+	originalAmount, originalCurrency, originalExchangeRate, originalExchangeRateDate := "9.3", "EUR", "0.918", "2023-09-30"
+
+	transaction := Transaction{Id: "ae90db91-d278-4941-b2b0-92e3b6f666e2", Date: date, Description: "foo & bar", AmountInUSD: "10.13"}
+	converted := ConvertedPurchasePrice{Country: "Nepal", Currency: "Rupee", ExchangeRateUsed: "130.5", ExchangeRateDate: "2023-09-30", Amount: "1321.97", Provider: &provider}
+
+	tests := []struct {
+		name string
+		give any
+		want string
+	}{
+		{
+			name: "transaction",
+			give: &transaction,
+			want: `<Transaction><amountInUSD>10.13</amountInUSD><date>2023-12-01T10:58:37Z</date><description>foo &amp; bar</description><id>ae90db91-d278-4941-b2b0-92e3b6f666e2</id></Transaction>`,
+		},
+		{
+			name: "foreign currency transaction",
+			give: &Transaction{Id: "ae90db91-d278-4941-b2b0-92e3b6f666e2", Date: date, Description: "foo", AmountInUSD: "10.13", OriginalAmount: &originalAmount, OriginalCurrency: &originalCurrency, OriginalExchangeRate: &originalExchangeRate, OriginalExchangeRateDate: &originalExchangeRateDate},
+			want: `<Transaction><amountInUSD>10.13</amountInUSD><date>2023-12-01T10:58:37Z</date><description>foo</description><id>ae90db91-d278-4941-b2b0-92e3b6f666e2</id>` +
+				`<originalAmount>9.3</originalAmount><originalCurrency>EUR</originalCurrency><originalExchangeRate>0.918</originalExchangeRate><originalExchangeRateDate>2023-09-30</originalExchangeRateDate></Transaction>`,
+		},
+		{
+			name: "converted purchase transaction",
+			give: &GetPurchaseTransaction{TransactionDetails: transaction, ConvertedDetails: converted},
+			want: `<GetPurchaseTransaction><convertedDetails><amount>1321.97</amount><country>Nepal</country><currency>Rupee</currency><exchangeRateDate>2023-09-30</exchangeRateDate><exchangeRateUsed>130.5</exchangeRateUsed><provider>treasury</provider></convertedDetails>` +
+				`<transactionDetails><amountInUSD>10.13</amountInUSD><date>2023-12-01T10:58:37Z</date><description>foo &amp; bar</description><id>ae90db91-d278-4941-b2b0-92e3b6f666e2</id></transactionDetails></GetPurchaseTransaction>`,
+		},
+		{
+			name: "page of purchase transactions",
+			give: &ListPurchaseTransactions{
+				Items: []PurchaseTransactionListItem{
+					{TransactionDetails: transaction, ConversionError: &conversionError},
+				},
+				NextCursor: &nextCursor,
+			},
+			want: `<ListPurchaseTransactions><items><conversionError>the purchase cannot be converted to the target currency</conversionError>` +
+				`<transactionDetails><amountInUSD>10.13</amountInUSD><date>2023-12-01T10:58:37Z</date><description>foo &amp; bar</description><id>ae90db91-d278-4941-b2b0-92e3b6f666e2</id></transactionDetails></items>` +
+				`<nextCursor>next</nextCursor></ListPurchaseTransactions>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xml.Marshal(tt.give)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, string(got))
+
+			// decoding the encoded XML should give back the same value
+			decoded := newZeroValue(tt.give)
+			err = xml.Unmarshal(got, decoded)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.give, decoded)
+		})
+	}
+}
+
+func newZeroValue(v any) any {
+	switch v.(type) {
+	case *Transaction:
+		return &Transaction{}
+	case *GetPurchaseTransaction:
+		return &GetPurchaseTransaction{}
+	case *ListPurchaseTransactions:
+		return &ListPurchaseTransactions{}
+	}
+
+	return nil
+}